@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// MigrationTestCase describes a Terraform Plugin SDK to Terraform Plugin Framework state compatibility test.
+type MigrationTestCase struct {
+	CheckDestroy resource.TestCheckFunc
+	ErrorCheck   resource.ErrorCheckFunc
+	PreCheck     func()
+
+	// Config or ConfigDirectory (with optional ConfigVariables) provide the test configuration.
+	Config          string
+	ConfigDirectory config.TestStepConfigFunc
+	ConfigVariables config.Variables
+
+	// ResourceName is the address of the resource under test, e.g. "aws_example_thing.test".
+	ResourceName string
+
+	// ProviderVersion is the Terraform Registry release of the provider, e.g. "5.74.0", that writes the initial state.
+	// It should be the last release in which the resource was implemented using the Terraform Plugin SDK.
+	ProviderVersion string
+}
+
+// MigrationTest verifies that a resource's state written by the released provider version can be read by
+// the current provider.
+//
+// The configuration is applied using the released provider and then planned using the current provider.
+// The test fails if the plan is not empty or if any of the resource's state values change.
+//
+// When VCR testing is enabled the AWS API interactions of both providers are recorded to, or replayed from,
+// a single cassette via an HTTPS proxy.
+// The proxy is configured via the process environment, so the test is only run in parallel when VCR testing is disabled.
+func MigrationTest(ctx context.Context, t *testing.T, c MigrationTestCase) {
+	t.Helper()
+
+	if c.ProviderVersion == "" {
+		t.Fatal("MigrationTestCase.ProviderVersion must be set")
+	}
+
+	test := resource.ParallelTest
+	if vcr.IsEnabled() {
+		startVCRProxy(ctx, t)
+		test = resource.Test
+	}
+
+	compareValues := &compareResourceValues{
+		resourceAddress: c.ResourceName,
+	}
+
+	test(t, resource.TestCase{
+		PreCheck:     c.PreCheck,
+		ErrorCheck:   c.ErrorCheck,
+		CheckDestroy: c.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: c.ProviderVersion,
+					},
+				},
+				Config:          c.Config,
+				ConfigDirectory: pinnedProviderConfigDirectory(t, c.ConfigDirectory, c.ProviderVersion),
+				ConfigVariables: c.ConfigVariables,
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareValues,
+				},
+			},
+			{
+				ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+				Config:                   c.Config,
+				ConfigDirectory:          c.ConfigDirectory,
+				ConfigVariables:          c.ConfigVariables,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareValues,
+				},
+			},
+		},
+	})
+}
+
+// pinnedProviderConfigDirectory returns a copy of the configuration directory that requires the specified provider version.
+//
+// Terraform configuration is not added to ConfigDirectory configurations for ExternalProviders,
+// so without a version constraint the latest provider release would be installed.
+// The configuration must not itself declare the provider's requirements.
+func pinnedProviderConfigDirectory(t *testing.T, f config.TestStepConfigFunc, version string) config.TestStepConfigFunc {
+	t.Helper()
+
+	if f == nil {
+		return nil
+	}
+
+	return func(req config.TestStepConfigRequest) string {
+		src := f(req)
+		if src == "" {
+			return ""
+		}
+
+		dst := t.TempDir()
+
+		entries, err := os.ReadDir(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() {
+				continue
+			}
+
+			b, err := os.ReadFile(filepath.Join(src, entry.Name()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := os.WriteFile(filepath.Join(dst, entry.Name()), b, 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		versions := fmt.Sprintf(`terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = %q
+    }
+  }
+}
+`, version)
+
+		if err := os.WriteFile(filepath.Join(dst, "versions_migration.tf"), []byte(versions), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		return dst
+	}
+}
+
+// startVCRProxy routes the AWS API interactions of all providers used by the test through a recording proxy.
+func startVCRProxy(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	vcrMode, err := vcr.Mode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		accountID     string
+		accountIDLock sync.Mutex
+	)
+	// The proxy is configured via the environment, so the recorder must connect to AWS directly.
+	transport := vcrRealTransport()
+	transport.Proxy = nil
+
+	r, err := newVCRRecorder(ctx, t.Name(), vcrMode,
+		func() string {
+			accountIDLock.Lock()
+			defer accountIDLock.Unlock()
+
+			return accountID
+		},
+		recorder.WithHook(vcr.CallerIdentityHook(func(v string) {
+			accountIDLock.Lock()
+			defer accountIDLock.Unlock()
+
+			accountID = v
+		}), recorder.AfterCaptureHook),
+		recorder.WithRealTransport(transport),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proxy, err := vcr.NewProxy(r, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(vcr.EnvVarHTTPSProxy, proxy.URL())
+	t.Setenv(vcr.EnvVarCABundle, proxy.CABundle())
+	t.Setenv("NO_PROXY", "")
+	t.Setenv("no_proxy", "")
+
+	t.Cleanup(func() {
+		if err := proxy.Close(); err != nil {
+			t.Error(err)
+		}
+
+		if !t.Failed() {
+			t.Log("stopping VCR recorder")
+			if err := r.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+
+		persistVCRRandomnessSeed(t)
+	})
+}
+
+var _ statecheck.StateCheck = &compareResourceValues{}
+
+// compareResourceValues is a state check that verifies that a resource's state values are
+// the same each time the check is run.
+type compareResourceValues struct {
+	resourceAddress string
+	values          map[string]any
+}
+
+func (c *compareResourceValues) CheckState(_ context.Context, req statecheck.CheckStateRequest, resp *statecheck.CheckStateResponse) {
+	if req.State == nil || req.State.Values == nil || req.State.Values.RootModule == nil {
+		resp.Error = fmt.Errorf("state does not contain a root module")
+		return
+	}
+
+	for _, r := range req.State.Values.RootModule.Resources {
+		if r.Address != c.resourceAddress {
+			continue
+		}
+
+		if c.values == nil {
+			c.values = r.AttributeValues
+			return
+		}
+
+		if diff := cmp.Diff(c.values, r.AttributeValues); diff != "" {
+			resp.Error = fmt.Errorf("%s - state values changed (-previous, +current): %s", c.resourceAddress, diff)
+		}
+
+		return
+	}
+
+	resp.Error = fmt.Errorf("%s - Resource not found in state", c.resourceAddress)
+}
@@ -15,6 +15,7 @@ import (
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 )
 
@@ -73,6 +74,12 @@ func (e expectFullTagsCheck) CheckState(ctx context.Context, req statecheck.Chec
 		} else {
 			err = v.ListTags(ctx, acctest.Provider.Meta(), identifier.(string), tagsSpec.Value().ResourceType) // Sets tags in Context
 		}
+	} else if tagsSpec.Value().ResourceGroupsTaggingAPI {
+		var tags map[string]tftags.KeyValueTags
+		tags, err = tftags.ListResourceTags(ctx, acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx), []string{identifier.(string)})
+		if inContext, ok := tftags.FromContext(ctx); ok && err == nil {
+			inContext.TagsOut = option.Some(tftags.New(ctx, tags[identifier.(string)]))
+		}
 	} else {
 		err = fmt.Errorf("no ListTags method found for service %s", sp.ServicePackageName())
 	}
@@ -7,22 +7,19 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -31,7 +28,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
-	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
@@ -135,110 +131,15 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Real transport config, cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		if tlsConfig := transport.TLSClientConfig; tlsConfig == nil {
-			tlsConfig = &tls.Config{
-				MinVersion: tls.VersionTLS13,
+		// Redact the AWS account ID used for recording.
+		accountID := func() string {
+			if meta == nil {
+				return ""
 			}
-			transport.TLSClientConfig = tlsConfig
+			return meta.AccountID(ctx)
 		}
 
-		// After capture hook to remove sensitive HTTP headers.
-		sensitiveHeaderHook := func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-			return nil
-		}
-
-		// Define how VCR will match requests to stored interactions.
-		matchFunc := func(r *http.Request, i cassette.Request) bool {
-			if r.Method != i.Method {
-				return false
-			}
-
-			if r.URL.String() != i.URL {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson any
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		}
-
-		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
-
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.New(cassetteName,
-			recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
-			recorder.WithMatcher(matchFunc),
-			recorder.WithMode(vcrMode),
-			recorder.WithRealTransport(httpClient.Transport),
-			recorder.WithSkipRequestLatency(true),
-		)
-
+		r, err := newVCRRecorder(ctx, testName, vcrMode, accountID)
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
@@ -246,7 +147,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient := &http.Client{Transport: r}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -267,11 +168,47 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// newVCRRecorder returns a VCR recorder for the named test's cassette
+//
+// The AWS account ID returned by accountID is redacted from saved interactions.
+// Additional options override the defaults.
+func newVCRRecorder(ctx context.Context, testName string, vcrMode recorder.Mode, accountID func() string, opts ...recorder.Option) (*recorder.Recorder, error) {
+	cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
+
+	opts = append([]recorder.Option{
+		recorder.WithHook(vcr.SensitiveHeadersHook(), recorder.AfterCaptureHook),
+		recorder.WithHook(vcr.RedactHook(accountID), recorder.BeforeSaveHook),
+		recorder.WithMatcher(vcr.RequestMatcher(ctx)),
+		recorder.WithMode(vcrMode),
+		recorder.WithRealTransport(vcrRealTransport()),
+		recorder.WithSkipRequestLatency(true),
+	}, opts...)
+
+	return recorder.New(cassetteName, opts...)
+}
+
+// vcrRealTransport returns the HTTP transport used by VCR recorders to send requests to AWS
+func vcrRealTransport() *http.Transport {
+	// Real transport config, cribbed from aws-sdk-go-base.
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.MaxIdleConnsPerHost = 10
+	if tlsConfig := transport.TLSClientConfig; tlsConfig == nil {
+		tlsConfig = &tls.Config{
+			MinVersion: tls.VersionTLS13,
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing
 //
 // In RECORD_ONLY mode, generates a new seed and saves it to a file, using the
 // seed for the source.
 // In REPLAY_ONLY mode, reads a seed from a file and creates a source from it.
+// In RECORD_IF_MISSING mode, reads a seed from a file if one exists, otherwise
+// generates a new seed.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
 	testName := t.Name()
@@ -303,6 +240,19 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in RECORD_ONLY mode - %w", testName, err)
 		}
 
+		s = &randomnessSource{
+			seed:   seed,
+			source: rand.NewSource(seed),
+		}
+	case recorder.ModeRecordOnce:
+		seed, err := readSeedFromFile(vcrSeedFile(vcr.Path(), testName))
+
+		if errors.Is(err, fs.ErrNotExist) {
+			seed = rand.Int63()
+		} else if err != nil {
+			return nil, err
+		}
+
 		s = &randomnessSource{
 			seed:   seed,
 			source: rand.NewSource(seed),
@@ -387,7 +337,14 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 		delete(providerMetas, testName)
 	}
 
-	// Save the randomness seed.
+	persistVCRRandomnessSeed(t)
+}
+
+// persistVCRRandomnessSeed saves the test's randomness seed, if any, for replaying
+func persistVCRRandomnessSeed(t *testing.T) {
+	t.Helper()
+
+	testName := t.Name()
 	randomnessSources.Lock()
 	s, ok := randomnessSources[testName]
 	defer randomnessSources.Unlock()
@@ -395,7 +352,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	if ok {
 		if !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(vcr.Path(), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(vcr.Path(), testName)); err != nil {
 				t.Error(err)
 			}
 		}
@@ -52,3 +52,24 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAY_ONLY: %s, RECORD_ONLY: %s", rep2, rec2)
 	}
 }
+
+func TestRandIntRecordIfMissing(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv("VCR_PATH", t.TempDir())
+	t.Setenv("VCR_MODE", "RECORD_IF_MISSING")
+
+	rec1 := acctest.RandInt(t)
+	rec2 := acctest.RandInt(t)
+	acctest.CloseVCRRecorder(ctx, t)
+
+	rep1 := acctest.RandInt(t)
+	rep2 := acctest.RandInt(t)
+
+	if rep1 != rec1 {
+		t.Errorf("replayed: %d, recorded: %d", rep1, rec1)
+	}
+	if rep2 != rec2 {
+		t.Errorf("replayed: %d, recorded: %d", rep2, rec2)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -24,25 +25,37 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 type AWSClient struct {
 	accountID                 string
+	allowedRegions            []string // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
+	emulatorEndpoint          string            // From provider configuration.
 	endpoints                 map[string]string // From provider configuration.
+	forbiddenRegions          []string          // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimiters              map[string]*rateLimiters               // Service package name -> rate limiters.
+	readCache                 *readCache                             // Nil if the read cache is disabled.
+	resourceTagsBatchers      map[string]*tftags.ResourceTagsBatcher // Region -> batcher.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
-	terraformVersion          string // From provider configuration.
+	tagPolicyConfig           *tftags.PolicyConfig
+	terraformVersion          string                   // From provider configuration.
+	tracer                    trace.Tracer             // Nil if tracing is not enabled.
+	tracerProvider            *sdktrace.TracerProvider // Nil if tracing is not enabled.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -81,6 +94,10 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -186,6 +203,26 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	return c.s3ExpressClient
 }
 
+// ResourceTagsBatcher returns the batcher of tag updates made using the Resource Groups Tagging API in the current Region.
+func (c *AWSClient) ResourceTagsBatcher(ctx context.Context) *tftags.ResourceTagsBatcher {
+	conn := c.ResourceGroupsTaggingAPIClient(ctx)
+	region := c.Region(ctx)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.resourceTagsBatchers == nil {
+		c.resourceTagsBatchers = make(map[string]*tftags.ResourceTagsBatcher)
+	}
+	batcher, ok := c.resourceTagsBatchers[region]
+	if !ok {
+		batcher = tftags.NewResourceTagsBatcher(conn)
+		c.resourceTagsBatchers[region] = batcher
+	}
+
+	return batcher
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -206,6 +243,30 @@ func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
 }
 
+// RegisterVCR places whether recorded VCR interactions are being replayed into the resource information kept in Context.
+func (c *AWSClient) RegisterVCR(ctx context.Context) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok || !v.vcrEnabled {
+		return ctx
+	}
+
+	inContext := *v
+	inContext.vcrReplaying = c.VCRReplaying(ctx)
+
+	return context.WithValue(ctx, contextKey, &inContext)
+}
+
+// VCRReplaying indicates whether the HTTP client used for AWS API calls is replaying recorded VCR interactions.
+func (c *AWSClient) VCRReplaying(context.Context) bool {
+	if c.httpClient == nil {
+		return false
+	}
+
+	r, ok := c.httpClient.Transport.(*recorder.Recorder)
+
+	return ok && !r.IsRecording()
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (c *AWSClient) APIGatewayInvokeURL(ctx context.Context, restAPIID, stageName string) string {
@@ -321,18 +382,41 @@ func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	return nil
 }
 
+// ValidateInContextRegionAllowed verifies that the value of the top-level `region` attribute is permitted by the provider's configured allowed and forbidden Regions.
+func (c *AWSClient) ValidateInContextRegionAllowed(ctx context.Context) error {
+	if inContext, ok := FromContext(ctx); ok {
+		if r := inContext.OverrideRegion(); r != "" {
+			if err := verifyRegionAllowed(r, c.allowedRegions, c.forbiddenRegions); err != nil {
+				return fmt.Errorf("per-resource Region: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	endpoint := c.endpoints[servicePackageName]
+	if endpoint == "" {
+		// All service packages use the AWS API emulator's endpoint unless explicitly overridden.
+		endpoint = c.emulatorEndpoint
+	}
 	m := map[string]any{
 		"aws_sdkv2_config": c.awsConfig,
-		"endpoint":         c.endpoints[servicePackageName],
+		"endpoint":         endpoint,
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if l, ok := c.rateLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), l.apiOption(servicePackageName))
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
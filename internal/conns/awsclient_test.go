@@ -252,3 +252,74 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 		})
 	}
 }
+
+func TestAWSClientValidateInContextRegionAllowed(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Region    string
+		Expected  bool
+	}{
+		{
+			Name:      "no guardrails",
+			AWSClient: &AWSClient{},
+			Region:    endpoints.ApNortheast1RegionID,
+			Expected:  true,
+		},
+		{
+			Name: "allowed, valid",
+			AWSClient: &AWSClient{
+				allowedRegions: []string{endpoints.UsWest2RegionID, endpoints.ApNortheast1RegionID},
+			},
+			Region:   endpoints.ApNortheast1RegionID,
+			Expected: true,
+		},
+		{
+			Name: "allowed, invalid",
+			AWSClient: &AWSClient{
+				allowedRegions: []string{endpoints.UsWest2RegionID},
+			},
+			Region:   endpoints.ApNortheast1RegionID,
+			Expected: false,
+		},
+		{
+			Name: "forbidden, valid",
+			AWSClient: &AWSClient{
+				forbiddenRegions: []string{endpoints.UsWest2RegionID},
+			},
+			Region:   endpoints.ApNortheast1RegionID,
+			Expected: true,
+		},
+		{
+			Name: "forbidden, invalid",
+			AWSClient: &AWSClient{
+				forbiddenRegions: []string{endpoints.ApNortheast1RegionID},
+			},
+			Region:   endpoints.ApNortheast1RegionID,
+			Expected: false,
+		},
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				allowedRegions: []string{endpoints.UsWest2RegionID},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(ctx, "test", "Test", testCase.Region)
+			err := testCase.AWSClient.ValidateInContextRegionAllowed(ctx)
+
+			if got := err == nil; got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
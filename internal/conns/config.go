@@ -6,11 +6,13 @@ package conns
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -23,11 +25,14 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationalUnits     []string
+	AllowedRegions                 []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -35,8 +40,10 @@ type Config struct {
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	Emulator                       *EmulatorConfig
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	ForbiddenRegions               []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
@@ -44,6 +51,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     []RateLimitConfig
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
@@ -52,13 +60,16 @@ type Config struct {
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
+	SkipReadCache                  bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
+	Tracing                        *TracingConfig
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 }
@@ -69,6 +80,37 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	if c.Emulator != nil {
+		tflog.Info(ctx, "Configuring Terraform AWS Provider for AWS API emulator", map[string]any{
+			"tf_aws.emulator.endpoint":   c.Emulator.Endpoint,
+			"tf_aws.emulator.account_id": c.Emulator.AccountID,
+		})
+		c.applyEmulator()
+	}
+
+	rateLimiters, err := newRateLimiters(c.RateLimits)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tracerProvider, err := newTracerProvider(ctx, c.Tracing)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+	var configured bool
+	if tracerProvider != nil {
+		// The provider is not used if configuration fails.
+		defer func() {
+			if !configured {
+				tracerProvider.Shutdown(ctx) //nolint:errcheck // configuration has already failed
+			}
+		}()
+
+		var span trace.Span
+		ctx, span = tracerProvider.Tracer(tracerName).Start(ctx, "ConfigureProvider")
+		defer span.End()
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -162,6 +204,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if tracerProvider != nil {
+		cfg.APIOptions = append(cfg.APIOptions, tracingAPIOptions(tracerProvider)...)
+	}
+
+	if err := verifyRegionAllowed(c.Region, c.AllowedRegions, c.ForbiddenRegions); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Retrieving AWS account details")
@@ -174,17 +224,38 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
+	if c.Emulator != nil {
+		accountID = c.Emulator.AccountID
+	}
+
 	if accountID == "" && !awsbaseConfig.SkipRequestingAccountId {
 		diags = append(diags, errs.NewWarningDiagnostic(
 			"AWS account ID not found for provider",
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
-	if err != nil {
+	if err := awsbaseConfig.VerifyAccountIDAllowed(accountID); err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
 
+	if len(c.AllowedOrganizationalUnits) > 0 {
+		endpoint := c.Endpoints[names.Organizations]
+		if endpoint == "" && c.Emulator != nil {
+			endpoint = c.Emulator.Endpoint
+		}
+
+		conn := organizations.NewFromConfig(cfg, func(o *organizations.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+		})
+
+		tflog.Debug(ctx, "Verifying AWS account's organizational unit")
+		if err := verifyOrganizationalUnitAllowed(ctx, conn, accountID, c.AllowedOrganizationalUnits); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	for _, partition := range endpoints.DefaultPartitions() {
 		if partition.ID() == partitionID {
 			client.partition = partition
@@ -192,22 +263,56 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.allowedRegions = c.AllowedRegions
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.forbiddenRegions = c.ForbiddenRegions
+	if c.Emulator != nil {
+		client.emulatorEndpoint = c.Emulator.Endpoint
+	}
 	client.logger = logger
+	client.rateLimiters = rateLimiters
+	if !c.SkipReadCache {
+		client.readCache = newReadCache()
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	if tracerProvider != nil {
+		client.tracer = tracerProvider.Tracer(tracerName)
+		client.tracerProvider = tracerProvider
+	}
+
+	configured = true
 
 	return client, diags
 }
 
+// verifyRegionAllowed verifies that an AWS Region is not explicitly forbidden
+// or omitted from an allow list, if configured.
+func verifyRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	if region == "" {
+		return nil
+	}
+
+	if slices.Contains(forbiddenRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	if len(allowedRegions) > 0 && !slices.Contains(allowedRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	return nil
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
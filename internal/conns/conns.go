@@ -27,6 +27,20 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithFrameworkListResources is an interface that extends ServicePackage with Plugin Framework list resources.
+// List resources are used by `terraform query` to discover existing resources.
+type ServicePackageWithFrameworkListResources interface {
+	ServicePackage
+	FrameworkListResources(context.Context) []*types.ServicePackageFrameworkListResource
+}
+
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+// List resources are used by `terraform query` to discover existing resources.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -41,6 +55,7 @@ type InContext struct {
 	resourceName       string // Friendly resource name, e.g. "Subnet"
 	servicePackageName string // Canonical name defined as a constant in names package
 	vcrEnabled         bool   // Whether VCR testing is enabled
+	vcrReplaying       bool   // Whether recorded VCR interactions are being replayed
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -63,6 +78,11 @@ func (c *InContext) VCREnabled() bool {
 	return c.vcrEnabled
 }
 
+// VCRReplaying indicates whether recorded VCR interactions are being replayed.
+func (c *InContext) VCRReplaying() bool {
+	return c.vcrReplaying
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		overrideRegion:     overrideRegion,
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"maps"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// DefaultEmulatorAccountID is the synthetic AWS account ID used when the provider targets a local AWS API emulator.
+	DefaultEmulatorAccountID = "000000000000"
+
+	// emulatorAccessKey and emulatorSecretKey are the static credentials used with a local AWS API emulator
+	// if no credentials are configured.
+	emulatorAccessKey = "test"
+	emulatorSecretKey = "test" // #nosec G101 -- Not a real credential.
+)
+
+// EmulatorConfig configures the provider to target a single local AWS API emulator, such as LocalStack or moto server.
+type EmulatorConfig struct {
+	AccountID string
+	Endpoint  string
+}
+
+// emulatorCredentialsEnvVars are the environment variables that supply credentials to the AWS SDK.
+// If any is set, the emulator's static credentials are not used.
+var emulatorCredentialsEnvVars = []string{
+	"AWS_ACCESS_KEY_ID",
+	"AWS_CONTAINER_CREDENTIALS_FULL_URI",
+	"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI",
+	"AWS_PROFILE",
+	"AWS_SECRET_ACCESS_KEY",
+	"AWS_WEB_IDENTITY_TOKEN_FILE",
+}
+
+// applyEmulator overrides the configuration so that all AWS API calls are made to the emulator
+// and no calls are made to the EC2 metadata service or to AWS STS to validate credentials or retrieve account details.
+// Explicitly configured per-service endpoints take precedence over the emulator endpoint.
+// Static credentials are used only if no credentials are configured in the provider or in the environment.
+func (c *Config) applyEmulator() {
+	if c.Emulator.AccountID == "" {
+		c.Emulator.AccountID = DefaultEmulatorAccountID
+	}
+
+	if !c.hasCredentials() {
+		c.AccessKey = emulatorAccessKey
+		c.SecretKey = emulatorSecretKey
+	}
+
+	m := make(map[string]string, len(c.Endpoints))
+	maps.Copy(m, c.Endpoints)
+	for _, k := range []string{names.IAM, names.SSO, names.STS} {
+		if m[k] == "" {
+			m[k] = c.Emulator.Endpoint
+		}
+	}
+	c.Endpoints = m
+
+	c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+}
+
+// hasCredentials returns whether a credential source is configured explicitly in the provider
+// or via the AWS SDK's standard environment variables.
+// The default shared credentials file is deliberately not considered so that real AWS credentials
+// are not sent to an emulator.
+func (c *Config) hasCredentials() bool {
+	if c.AccessKey != "" || c.SecretKey != "" || c.Profile != "" {
+		return true
+	}
+
+	if len(c.SharedConfigFiles) > 0 || len(c.SharedCredentialsFiles) > 0 {
+		return true
+	}
+
+	for _, k := range emulatorCredentialsEnvVars {
+		if os.Getenv(k) != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigApplyEmulator(t *testing.T) {
+	const endpoint = "http://localhost:4566"
+
+	testCases := map[string]struct {
+		config               Config
+		environmentVariables map[string]string
+		expectedAccessKey    string
+		expectedSecretKey    string
+		expectedAccountID    string
+		expectedEndpoints    map[string]string
+	}{
+		"no credentials": {
+			config:            Config{},
+			expectedAccessKey: emulatorAccessKey,
+			expectedSecretKey: emulatorSecretKey,
+			expectedAccountID: DefaultEmulatorAccountID,
+			expectedEndpoints: map[string]string{
+				names.IAM: endpoint,
+				names.SSO: endpoint,
+				names.STS: endpoint,
+			},
+		},
+		"configured account ID and endpoint override": {
+			config: Config{
+				Emulator: &EmulatorConfig{
+					AccountID: "123456789012",
+				},
+				Endpoints: map[string]string{
+					names.STS: "http://localhost:5000",
+				},
+			},
+			expectedAccessKey: emulatorAccessKey,
+			expectedSecretKey: emulatorSecretKey,
+			expectedAccountID: "123456789012",
+			expectedEndpoints: map[string]string{
+				names.IAM: endpoint,
+				names.SSO: endpoint,
+				names.STS: "http://localhost:5000",
+			},
+		},
+		"static credentials in configuration": {
+			config: Config{
+				AccessKey: "AKIAEXAMPLE",
+				SecretKey: "secret",
+			},
+			expectedAccessKey: "AKIAEXAMPLE",
+			expectedSecretKey: "secret",
+			expectedAccountID: DefaultEmulatorAccountID,
+		},
+		"profile in configuration": {
+			config: Config{
+				Profile: "emulator",
+			},
+			expectedAccountID: DefaultEmulatorAccountID,
+		},
+		"shared credentials file in configuration": {
+			config: Config{
+				SharedCredentialsFiles: []string{"/tmp/credentials"},
+			},
+			expectedAccountID: DefaultEmulatorAccountID,
+		},
+		"static credentials in environment": {
+			environmentVariables: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "AKIAEXAMPLE",
+				"AWS_SECRET_ACCESS_KEY": "secret",
+			},
+			expectedAccountID: DefaultEmulatorAccountID,
+		},
+		"profile in environment": {
+			environmentVariables: map[string]string{
+				"AWS_PROFILE": "emulator",
+			},
+			expectedAccountID: DefaultEmulatorAccountID,
+		},
+		"web identity in environment": {
+			environmentVariables: map[string]string{
+				"AWS_WEB_IDENTITY_TOKEN_FILE": "/tmp/token",
+			},
+			expectedAccountID: DefaultEmulatorAccountID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range emulatorCredentialsEnvVars {
+				t.Setenv(k, "")
+			}
+			for k, v := range testCase.environmentVariables {
+				t.Setenv(k, v)
+			}
+
+			config := testCase.config
+			if config.Emulator == nil {
+				config.Emulator = &EmulatorConfig{}
+			}
+			config.Emulator.Endpoint = endpoint
+
+			config.applyEmulator()
+
+			if got, want := config.AccessKey, testCase.expectedAccessKey; got != want {
+				t.Errorf("AccessKey = %q, want %q", got, want)
+			}
+			if got, want := config.SecretKey, testCase.expectedSecretKey; got != want {
+				t.Errorf("SecretKey = %q, want %q", got, want)
+			}
+			if got, want := config.Emulator.AccountID, testCase.expectedAccountID; got != want {
+				t.Errorf("Emulator.AccountID = %q, want %q", got, want)
+			}
+			if testCase.expectedEndpoints != nil {
+				if diff := cmp.Diff(config.Endpoints, testCase.expectedEndpoints); diff != "" {
+					t.Errorf("unexpected Endpoints (-got +want):\n%s", diff)
+				}
+			}
+			if got, want := config.EC2MetadataServiceEnableState, imds.ClientDisabled; got != want {
+				t.Errorf("EC2MetadataServiceEnableState = %v, want %v", got, want)
+			}
+			if !config.S3UsePathStyle || !config.SkipCredsValidation || !config.SkipRegionValidation || !config.SkipRequestingAccountId {
+				t.Error("expected S3 path-style addressing and skipped credentials validation, region validation and account ID lookup")
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// verifyOrganizationalUnitAllowed verifies that an AWS account is contained, directly or via nested organizational units,
+// in one of the allowed organizational units (or organization roots).
+func verifyOrganizationalUnitAllowed(ctx context.Context, conn *organizations.Client, accountID string, allowedOrganizationalUnits []string) error {
+	if accountID == "" {
+		return errors.New("AWS account ID is required to verify the account's organizational unit, set skip_requesting_account_id to false")
+	}
+
+	for childID := accountID; ; {
+		output, err := conn.ListParents(ctx, &organizations.ListParentsInput{
+			ChildId: aws.String(childID),
+		})
+
+		if errs.IsA[*organizationstypes.AWSOrganizationsNotInUseException](err) {
+			return fmt.Errorf("AWS account ID not allowed: %s is not a member of an AWS Organization", accountID)
+		}
+
+		// ListParents can only be called from the organization's management account or a delegated administrator account.
+		if errs.IsA[*organizationstypes.AccessDeniedException](err) {
+			return fmt.Errorf("reading AWS account (%s) organizational unit: allowed_organizational_units requires credentials for the organization's management account or a delegated administrator account with the organizations:ListParents permission: %w", accountID, err)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading AWS account (%s) organizational unit: %w", accountID, err)
+		}
+
+		if len(output.Parents) == 0 {
+			break
+		}
+
+		parent := output.Parents[0]
+		parentID := aws.ToString(parent.Id)
+		if slices.Contains(allowedOrganizationalUnits, parentID) {
+			return nil
+		}
+
+		if parent.Type == organizationstypes.ParentTypeRoot {
+			break
+		}
+
+		childID = parentID
+	}
+
+	return fmt.Errorf("AWS account ID not allowed: %s is not in any of the allowed organizational units", accountID)
+}
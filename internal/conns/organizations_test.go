@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestVerifyOrganizationalUnitAllowed(t *testing.T) {
+	t.Parallel()
+
+	const accountID = "123456789012"
+
+	// Account 123456789012 is in ou-nested, which is in ou-parent, which is in the root r-root.
+	parents := map[string]organizationstypes.Parent{
+		accountID: {
+			Id:   aws.String("ou-nested"),
+			Type: organizationstypes.ParentTypeOrganizationalUnit,
+		},
+		"ou-nested": {
+			Id:   aws.String("ou-parent"),
+			Type: organizationstypes.ParentTypeOrganizationalUnit,
+		},
+		"ou-parent": {
+			Id:   aws.String("r-root"),
+			Type: organizationstypes.ParentTypeRoot,
+		},
+	}
+
+	testCases := map[string]struct {
+		accountID                  string
+		allowedOrganizationalUnits []string
+		err                        error
+		expectedError              string
+	}{
+		"direct parent": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"ou-nested"},
+		},
+		"nested organizational unit": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"ou-other", "ou-parent"},
+		},
+		"root": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"r-root"},
+		},
+		"not allowed": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"ou-other"},
+			expectedError:              "is not in any of the allowed organizational units",
+		},
+		"no account ID": {
+			allowedOrganizationalUnits: []string{"ou-nested"},
+			expectedError:              "AWS account ID is required",
+		},
+		"access denied": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"ou-nested"},
+			err:                        &organizationstypes.AccessDeniedException{Message: aws.String("not authorized")},
+			expectedError:              "requires credentials for the organization's management account or a delegated administrator account",
+		},
+		"not in an organization": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"ou-nested"},
+			err:                        &organizationstypes.AWSOrganizationsNotInUseException{Message: aws.String("not in use")},
+			expectedError:              "is not a member of an AWS Organization",
+		},
+		"other error": {
+			accountID:                  accountID,
+			allowedOrganizationalUnits: []string{"ou-nested"},
+			err:                        errors.New("throttled"),
+			expectedError:              "throttled",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			conn := organizations.New(organizations.Options{
+				Region:      endpoints.UsEast1RegionID,
+				Credentials: aws.AnonymousCredentials{},
+				APIOptions: []func(*middleware.Stack) error{
+					func(stack *middleware.Stack) error {
+						return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stub", func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							if testCase.err != nil {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, testCase.err
+							}
+							output := &organizations.ListParentsOutput{}
+							if parent, ok := parents[aws.ToString(in.Parameters.(*organizations.ListParentsInput).ChildId)]; ok {
+								output.Parents = []organizationstypes.Parent{parent}
+							}
+							return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+						}), middleware.Before)
+					},
+				},
+			})
+
+			err := verifyOrganizationalUnitAllowed(ctx, conn, testCase.accountID, testCase.allowedOrganizationalUnits)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error containing %q, got none", testCase.expectedError)
+			} else if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("error %q does not contain %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// RateLimitConfig configures a client-side limit on the rate of AWS API calls to a service or to a single operation of a service.
+type RateLimitConfig struct {
+	Burst             int
+	Operation         string
+	RequestsPerSecond float64
+	Service           string
+}
+
+// rateLimiters are the client-side rate limiters for a single service.
+type rateLimiters struct {
+	operations map[string]*tfsync.TokenBucket // Operation name -> limiter.
+	service    *tfsync.TokenBucket
+}
+
+// newRateLimiters returns the rate limiters for the configured limits, keyed by service package name.
+func newRateLimiters(configs []RateLimitConfig) (map[string]*rateLimiters, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	m := make(map[string]*rateLimiters)
+	for _, config := range configs {
+		servicePackageName := config.Service
+		if !slices.Contains(names.ProviderPackages(), servicePackageName) {
+			v, err := names.ProviderPackageForAlias(servicePackageName)
+			if err != nil {
+				return nil, fmt.Errorf("rate limit: %w", err)
+			}
+			servicePackageName = v
+		}
+
+		if config.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("rate limit (%s): requests per second must be greater than 0", config.Service)
+		}
+
+		l, ok := m[servicePackageName]
+		if !ok {
+			l = &rateLimiters{}
+			m[servicePackageName] = l
+		}
+
+		limiter := tfsync.NewTokenBucket(config.RequestsPerSecond, config.Burst)
+		if config.Operation == "" {
+			if l.service != nil {
+				return nil, fmt.Errorf("rate limit (%s): duplicate service rate limit", config.Service)
+			}
+			l.service = limiter
+		} else {
+			if l.operations == nil {
+				l.operations = make(map[string]*tfsync.TokenBucket)
+			}
+			if _, ok := l.operations[config.Operation]; ok {
+				return nil, fmt.Errorf("rate limit (%s): duplicate operation (%s) rate limit", config.Service, config.Operation)
+			}
+			l.operations[config.Operation] = limiter
+		}
+	}
+
+	return m, nil
+}
+
+// wait blocks until both the operation's and the service's rate limits allow a call.
+func (l *rateLimiters) wait(ctx context.Context, operation string) (waitOperation, waitService time.Duration, err error) {
+	if v, ok := l.operations[operation]; ok {
+		if waitOperation, err = v.Wait(ctx); err != nil {
+			return waitOperation, waitService, err
+		}
+	}
+
+	if v := l.service; v != nil {
+		if waitService, err = v.Wait(ctx); err != nil {
+			return waitOperation, waitService, err
+		}
+	}
+
+	return waitOperation, waitService, nil
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that adds rate limiting middleware to a service client.
+// The middleware runs on every attempt, so retries are also rate limited.
+func (l *rateLimiters) apiOption(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		m := middleware.FinalizeMiddlewareFunc("TerraformRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			waitOperation, waitService, err := l.wait(ctx, operation)
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for %s %s rate limit: %w", servicePackageName, operation, err)
+			}
+
+			if wait := waitOperation + waitService; wait > 0 {
+				tflog.Debug(ctx, "AWS API call rate limited", map[string]any{
+					"tf_aws.service_package":           servicePackageName,
+					"tf_aws.rate_limit.operation":      operation,
+					"tf_aws.rate_limit.wait":           wait.String(),
+					"tf_aws.rate_limit.wait_operation": waitOperation.String(),
+					"tf_aws.rate_limit.wait_service":   waitService.String(),
+				})
+			}
+
+			return next.HandleFinalize(ctx, in)
+		})
+
+		// Rate limit each attempt, before the request is signed.
+		if err := stack.Finalize.Insert(m, "Retry", middleware.After); err != nil {
+			return stack.Finalize.Add(m, middleware.Before)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configs            []RateLimitConfig
+		expectedServices   []string
+		expectedOperations map[string][]string
+		expectedError      bool
+	}{
+		"none": {},
+		"service and operations": {
+			configs: []RateLimitConfig{
+				{Service: "ec2", RequestsPerSecond: 20},
+				{Service: "ec2", Operation: "DescribeInstances", RequestsPerSecond: 5},
+				{Service: "s3", Operation: "ListObjectsV2", RequestsPerSecond: 0.5, Burst: 2},
+			},
+			expectedServices: []string{"ec2", "s3"},
+			expectedOperations: map[string][]string{
+				"ec2": {"DescribeInstances"},
+				"s3":  {"ListObjectsV2"},
+			},
+		},
+		"service alias": {
+			configs: []RateLimitConfig{
+				{Service: "cloudwatchlogs", RequestsPerSecond: 10},
+			},
+			expectedServices: []string{"logs"},
+		},
+		"invalid service": {
+			configs: []RateLimitConfig{
+				{Service: "nosuchservice", RequestsPerSecond: 10},
+			},
+			expectedError: true,
+		},
+		"invalid rate": {
+			configs: []RateLimitConfig{
+				{Service: "ec2", RequestsPerSecond: 0},
+			},
+			expectedError: true,
+		},
+		"duplicate service": {
+			configs: []RateLimitConfig{
+				{Service: "logs", RequestsPerSecond: 10},
+				{Service: "cloudwatchlogs", RequestsPerSecond: 20},
+			},
+			expectedError: true,
+		},
+		"duplicate operation": {
+			configs: []RateLimitConfig{
+				{Service: "ec2", Operation: "DescribeInstances", RequestsPerSecond: 5},
+				{Service: "ec2", Operation: "DescribeInstances", RequestsPerSecond: 10},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newRateLimiters(testCase.configs)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("newRateLimiters() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(slices.Sorted(maps.Keys(got)), testCase.expectedServices); diff != "" {
+				t.Errorf("unexpected services diff (+wanted, -got): %s", diff)
+			}
+
+			for service, expected := range testCase.expectedOperations {
+				if diff := cmp.Diff(slices.Sorted(maps.Keys(got[service].operations)), expected); diff != "" {
+					t.Errorf("unexpected %s operations diff (+wanted, -got): %s", service, diff)
+				}
+			}
+		})
+	}
+}
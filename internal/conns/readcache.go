@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCache is a read-through cache of the results of AWS API calls that list or describe the children of a parent, e.g. the record sets in a Route 53 hosted zone.
+// Results are shared by the Read operations of a single provider instance, so that one paginated list call serves the reads of many resources.
+type readCache struct {
+	counts  map[readCacheKey]int
+	entries map[readCacheKey]*readCacheEntry
+	lock    sync.Mutex
+}
+
+type readCacheKey struct {
+	operation string
+	parentID  string
+	region    string
+	service   string
+}
+
+type readCacheEntry struct {
+	done  chan struct{} // Closed when the read completes.
+	err   error
+	value any
+}
+
+type readCacheClientKey struct{}
+
+func newReadCache() *readCache {
+	return &readCache{
+		counts:  make(map[readCacheKey]int),
+		entries: make(map[readCacheKey]*readCacheEntry),
+	}
+}
+
+// invalidate discards all cached results.
+func (c *readCache) invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.counts = make(map[readCacheKey]int)
+	c.entries = make(map[readCacheKey]*readCacheEntry)
+}
+
+// ReadCacheScope returns a Context in which finders can use the read-through cache during the specified Terraform operation, e.g. Read.
+// Results are cached for Read operations only. Create, Update and Delete operations discard all cached results when they start and when they complete.
+// The returned function must be called when the operation completes.
+func (c *AWSClient) ReadCacheScope(ctx context.Context, operation string) (context.Context, func()) {
+	if c == nil || c.readCache == nil {
+		return ctx, func() {}
+	}
+
+	switch operation {
+	case "Read":
+		return context.WithValue(ctx, readCacheClientKey{}, c), func() {}
+	case "Create", "Update", "Delete":
+		c.readCache.invalidate()
+		return ctx, c.readCache.invalidate
+	default:
+		return ctx, func() {}
+	}
+}
+
+// ReadCount records a read of one of the children of the specified parent and returns the number of such reads, including this one,
+// since cached results were last discarded. It returns 0 if the read-through cache is not enabled.
+// Finders can use it to choose between a narrowly scoped AWS API call and a call that serves the reads of many resources.
+func ReadCount(ctx context.Context, service, operation, parentID string) int {
+	c, ok := ctx.Value(readCacheClientKey{}).(*AWSClient)
+	if !ok {
+		return 0
+	}
+
+	key := readCacheKey{
+		operation: operation,
+		parentID:  parentID,
+		region:    c.Region(ctx),
+		service:   service,
+	}
+
+	cache := c.readCache
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cache.counts[key]++
+
+	return cache.counts[key]
+}
+
+// CachedRead returns the result of f, which reads all the children of the specified parent using the specified service's AWS API operation.
+// If the read-through cache is enabled the result is cached, keyed by Region, service, operation and parent identifier,
+// and concurrent reads with the same key wait for the first to complete. Errors are not cached.
+// Cached results are shared and must not be modified.
+func CachedRead[T any](ctx context.Context, service, operation, parentID string, f func(context.Context) (T, error)) (T, error) {
+	c, ok := ctx.Value(readCacheClientKey{}).(*AWSClient)
+	if !ok {
+		return f(ctx)
+	}
+
+	key := readCacheKey{
+		operation: operation,
+		parentID:  parentID,
+		region:    c.Region(ctx),
+		service:   service,
+	}
+	logFields := map[string]any{
+		"tf_aws.read_cache.operation": operation,
+		"tf_aws.read_cache.parent_id": parentID,
+		"tf_aws.read_cache.service":   service,
+	}
+
+	cache := c.readCache
+	cache.lock.Lock()
+	entries := cache.entries
+	e, ok := entries[key]
+	if !ok {
+		e = &readCacheEntry{
+			done: make(chan struct{}),
+		}
+		entries[key] = e
+	}
+	cache.lock.Unlock()
+
+	if ok {
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+
+		// The first read failed, so read again.
+		if e.err != nil {
+			tflog.Debug(ctx, "Read cache miss", logFields)
+			return f(ctx)
+		}
+
+		tflog.Debug(ctx, "Read cache hit", logFields)
+		return e.value.(T), nil
+	}
+
+	tflog.Debug(ctx, "Read cache miss", logFields)
+
+	v, err := f(ctx)
+
+	e.value, e.err = v, err
+	if err != nil {
+		cache.lock.Lock()
+		if entries[key] == e {
+			delete(entries, key)
+		}
+		cache.lock.Unlock()
+	}
+	close(e.done)
+
+	return v, err
+}
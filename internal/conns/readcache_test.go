@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestCachedRead(t *testing.T) {
+	t.Parallel()
+
+	type read struct {
+		operation string // Terraform operation.
+		parentID  string
+		region    string
+		err       error
+	}
+
+	testCases := map[string]struct {
+		disabled  bool
+		reads     []read
+		wantCalls int
+	}{
+		"same parent": {
+			reads: []read{
+				{operation: "Read", parentID: "p1"},
+				{operation: "Read", parentID: "p1"},
+				{operation: "Read", parentID: "p1"},
+			},
+			wantCalls: 1,
+		},
+		"different parents": {
+			reads: []read{
+				{operation: "Read", parentID: "p1"},
+				{operation: "Read", parentID: "p2"},
+				{operation: "Read", parentID: "p1"},
+			},
+			wantCalls: 2,
+		},
+		"different Regions": {
+			reads: []read{
+				{operation: "Read", parentID: "p1"},
+				{operation: "Read", parentID: "p1", region: "us-east-1"}, //lintignore:AWSAT003
+			},
+			wantCalls: 2,
+		},
+		"disabled": {
+			disabled: true,
+			reads: []read{
+				{operation: "Read", parentID: "p1"},
+				{operation: "Read", parentID: "p1"},
+			},
+			wantCalls: 2,
+		},
+		"not Read": {
+			reads: []read{
+				{operation: "Plan", parentID: "p1"},
+				{operation: "Plan", parentID: "p1"},
+			},
+			wantCalls: 2,
+		},
+		"invalidated by Update": {
+			reads: []read{
+				{operation: "Read", parentID: "p1"},
+				{operation: "Update", parentID: "p1"},
+				{operation: "Read", parentID: "p1"},
+			},
+			wantCalls: 3,
+		},
+		"error not cached": {
+			reads: []read{
+				{operation: "Read", parentID: "p1", err: errors.New("test")},
+				{operation: "Read", parentID: "p1"},
+				{operation: "Read", parentID: "p1"},
+			},
+			wantCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				awsConfig: &aws.Config{
+					Region: "us-west-2", //lintignore:AWSAT003
+				},
+			}
+			if !testCase.disabled {
+				client.readCache = newReadCache()
+			}
+
+			var calls int
+			for _, read := range testCase.reads {
+				ctx := t.Context()
+				if read.region != "" {
+					ctx = NewResourceContext(ctx, "test", "Test", read.region)
+				}
+
+				ctx, endReadCacheScope := client.ReadCacheScope(ctx, read.operation)
+				_, err := CachedRead(ctx, "test", "ListThings", read.parentID, func(context.Context) ([]string, error) {
+					calls++
+					return []string{read.parentID}, read.err
+				})
+				endReadCacheScope()
+
+				if !errors.Is(err, read.err) {
+					t.Errorf("got error %v, want %v", err, read.err)
+				}
+			}
+
+			if got, want := calls, testCase.wantCalls; got != want {
+				t.Errorf("got %d calls, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestReadCount(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		readCache: newReadCache(),
+	}
+
+	read := func(operation, parentID string) int {
+		ctx, endReadCacheScope := client.ReadCacheScope(t.Context(), operation)
+		defer endReadCacheScope()
+
+		return ReadCount(ctx, "test", "ListThings", parentID)
+	}
+
+	for i, want := range []int{1, 2, 3} {
+		if got := read("Read", "p1"); got != want {
+			t.Errorf("read %d: got count %d, want %d", i, got, want)
+		}
+	}
+	if got, want := read("Read", "p2"), 1; got != want {
+		t.Errorf("different parent: got count %d, want %d", got, want)
+	}
+	if got, want := read("Plan", "p1"), 0; got != want {
+		t.Errorf("not Read: got count %d, want %d", got, want)
+	}
+	read("Update", "p1")
+	if got, want := read("Read", "p1"), 1; got != want {
+		t.Errorf("invalidated by Update: got count %d, want %d", got, want)
+	}
+}
@@ -4,9 +4,26 @@
 package conns
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// SetAPIClient is only intended for use in tests
+func SetAPIClient(client *AWSClient, region, servicePackageName string, apiClient any) {
+	if client.clients == nil {
+		client.clients = make(map[string]map[string]any)
+	}
+	if client.clients[region] == nil {
+		client.clients[region] = make(map[string]any)
+	}
+	client.clients[region][servicePackageName] = apiClient
+}
+
+// SetAWSConfig is only intended for use in tests
+func SetAWSConfig(client *AWSClient, cfg *aws.Config) {
+	client.awsConfig = cfg
+}
+
 // SetDefaultTagsConfig is only intended for use in tests
 func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 	client.defaultTagsConfig = d
@@ -16,3 +33,8 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetTagPolicyConfig is only intended for use in tests
+func SetTagPolicyConfig(client *AWSClient, p *tftags.PolicyConfig) {
+	client.tagPolicyConfig = p
+}
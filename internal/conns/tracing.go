@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingConfig configures the export of OpenTelemetry traces of Terraform operations and the AWS API calls that they make.
+type TracingConfig struct {
+	Endpoint string // OTLP/HTTP endpoint URL of a trace collector.
+	File     string // Path of a file that spans are appended to as JSON.
+}
+
+const (
+	otlpTracesPath = "/v1/traces"
+	tracerName     = "github.com/hashicorp/terraform-provider-aws"
+)
+
+// newTracerProvider returns an OpenTelemetry tracer provider that exports spans as configured, or nil if tracing is not enabled.
+// Spans are exported in batches, so the tracer provider must be shut down to export any remaining spans.
+func newTracerProvider(ctx context.Context, config *TracingConfig) (*sdktrace.TracerProvider, error) {
+	if config == nil || (config.Endpoint == "" && config.File == "") {
+		return nil, nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "terraform-provider-aws"),
+			attribute.String("service.version", version.ProviderVersion),
+		)),
+	}
+
+	if v := config.Endpoint; v != "" {
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid trace collector endpoint URL: %s", v)
+		}
+
+		exporterOpts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(v)}
+		if u.Path == "" || u.Path == "/" {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithURLPath(otlpTracesPath))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP trace exporter (%s): %w", v, err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	if v := config.File; v != "" {
+		f, err := os.OpenFile(v, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("opening trace file (%s): %w", v, err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("creating file trace exporter (%s): %w", v, err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(fileSpanExporter{SpanExporter: exporter, file: f}))
+	}
+
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+// fileSpanExporter is a span exporter that closes the file that spans are written to when it is shut down.
+type fileSpanExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+func (e fileSpanExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.SpanExporter.Shutdown(ctx), e.file.Close())
+}
+
+// Shutdown exports any remaining trace spans and releases the resources used for tracing.
+// No spans are traced after shutdown.
+func (c *AWSClient) Shutdown(ctx context.Context) error {
+	if c == nil || c.tracerProvider == nil {
+		return nil
+	}
+
+	return c.tracerProvider.Shutdown(ctx)
+}
+
+// apiCallStats are the statistics of the AWS API calls made during a traced Terraform operation or a single AWS API call.
+type apiCallStats struct {
+	attempts  atomic.Int64
+	calls     atomic.Int64
+	throttles atomic.Int64
+}
+
+type (
+	apiCallStatsKey   struct{}
+	operationStatsKey struct{}
+)
+
+// tracingAPIOptions returns AWS SDK for Go v2 API options that trace each AWS API call as a span.
+// Each span records the number of attempts and throttled attempts made for the call.
+func tracingAPIOptions(tracerProvider trace.TracerProvider) []func(*middleware.Stack) error {
+	var apiOptions []func(*middleware.Stack) error
+
+	otelaws.AppendMiddlewares(&apiOptions, otelaws.WithTracerProvider(tracerProvider))
+
+	return append(apiOptions, func(stack *middleware.Stack) error {
+		// Runs inside the AWS API call's span.
+		call := middleware.InitializeMiddlewareFunc("TerraformTracingCall", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			var stats apiCallStats
+			ctx = context.WithValue(ctx, apiCallStatsKey{}, &stats)
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			attempts, throttles := stats.attempts.Load(), stats.throttles.Load()
+			trace.SpanFromContext(ctx).SetAttributes(
+				attribute.Int64("tf_aws.attempts", attempts),
+				attribute.Int64("tf_aws.throttles", throttles),
+			)
+
+			if v, ok := ctx.Value(operationStatsKey{}).(*apiCallStats); ok {
+				v.calls.Add(1)
+				v.attempts.Add(attempts)
+				v.throttles.Add(throttles)
+			}
+
+			return out, metadata, err
+		})
+
+		if err := stack.Initialize.Add(call, middleware.After); err != nil {
+			return err
+		}
+
+		isErrorThrottle := retry.IsErrorThrottles(retry.DefaultThrottles)
+		attempt := middleware.FinalizeMiddlewareFunc("TerraformTracingAttempt", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleFinalize(ctx, in)
+
+			if v, ok := ctx.Value(apiCallStatsKey{}).(*apiCallStats); ok {
+				v.attempts.Add(1)
+				if err != nil && isErrorThrottle.IsErrorThrottle(err) == aws.TrueTernary {
+					v.throttles.Add(1)
+				}
+			}
+
+			return out, metadata, err
+		})
+
+		// Count each attempt.
+		if err := stack.Finalize.Insert(attempt, "Retry", middleware.After); err != nil {
+			return stack.Finalize.Add(attempt, middleware.Before)
+		}
+
+		return nil
+	})
+}
+
+// StartSpan starts a trace span for a Terraform operation, e.g. Read, on a resource, data source, ephemeral resource or list resource.
+// AWS API calls made with the returned Context are traced as child spans.
+// The returned function ends the span, recording the resource's ID and a summary of the AWS API calls made.
+func (c *AWSClient) StartSpan(ctx context.Context, typeName, operation string) (context.Context, func(id string, failed bool)) {
+	if c == nil || c.tracer == nil {
+		return ctx, func(string, bool) {}
+	}
+
+	var stats apiCallStats
+	ctx = context.WithValue(ctx, operationStatsKey{}, &stats)
+
+	attributes := []attribute.KeyValue{
+		attribute.String("terraform.operation", operation),
+		attribute.String("terraform.resource_type", typeName),
+	}
+	if v, ok := FromContext(ctx); ok {
+		attributes = append(attributes, attribute.String("tf_aws.service_package", v.ServicePackageName()))
+	}
+
+	start := time.Now()
+	ctx, span := c.tracer.Start(ctx, typeName+" "+operation, trace.WithAttributes(attributes...), trace.WithTimestamp(start))
+
+	return ctx, func(id string, failed bool) {
+		calls, attempts, throttles := stats.calls.Load(), stats.attempts.Load(), stats.throttles.Load()
+
+		span.SetAttributes(
+			attribute.String("terraform.resource_id", id),
+			attribute.Int64("tf_aws.api_calls", calls),
+			attribute.Int64("tf_aws.api_retries", attempts-calls),
+			attribute.Int64("tf_aws.api_throttles", throttles),
+		)
+		if failed {
+			span.SetStatus(codes.Error, operation+" failed")
+		}
+		span.End()
+
+		tflog.Debug(ctx, "Terraform operation AWS API call summary", map[string]any{
+			"tf_aws.trace.api_calls":     calls,
+			"tf_aws.trace.api_retries":   attempts - calls,
+			"tf_aws.trace.api_throttles": throttles,
+			"tf_aws.trace.duration":      time.Since(start).String(),
+			"tf_aws.trace.operation":     operation,
+			"tf_aws.trace.resource_id":   id,
+			"tf_aws.trace.resource_type": typeName,
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAWSClientStartSpan(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+
+	// The first request is throttled.
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`)) //nolint:errcheck // test server
+			return
+		}
+		w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Arn>arn:aws:iam::123456789012:user/test</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId><Account>123456789012</Account></GetCallerIdentityResult><ResponseMetadata><RequestId>2</RequestId></ResponseMetadata></GetCallerIdentityResponse>`)) //nolint:errcheck // test server
+	}))
+	t.Cleanup(server.Close)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	cfg := aws.Config{
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:       "us-west-2", //lintignore:AWSAT003
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+			})
+		},
+	}
+	cfg.APIOptions = append(cfg.APIOptions, tracingAPIOptions(tracerProvider)...)
+
+	client := &AWSClient{
+		tracer: tracerProvider.Tracer(tracerName),
+	}
+
+	ctx, endSpan := client.StartSpan(ctx, "aws_test", "Read")
+	if _, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	endSpan("test-id", false)
+
+	spans := exporter.GetSpans()
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("got %d spans, want %d", got, want)
+	}
+
+	call, operation := spans[0], spans[1]
+	if got, want := call.Name, "STS.GetCallerIdentity"; got != want {
+		t.Errorf("got API call span name %q, want %q", got, want)
+	}
+	if got, want := call.Parent.SpanID(), operation.SpanContext.SpanID(); got != want {
+		t.Errorf("got API call span parent %s, want %s", got, want)
+	}
+	if got, want := operation.Name, "aws_test Read"; got != want {
+		t.Errorf("got operation span name %q, want %q", got, want)
+	}
+
+	attributes := func(kvs []attribute.KeyValue, keys ...string) map[string]any {
+		m := make(map[string]any)
+		for _, kv := range kvs {
+			for _, k := range keys {
+				if string(kv.Key) == k {
+					m[k] = kv.Value.AsInterface()
+				}
+			}
+		}
+		return m
+	}
+
+	if diff := cmp.Diff(attributes(call.Attributes, "tf_aws.attempts", "tf_aws.throttles"), map[string]any{
+		"tf_aws.attempts":  int64(2),
+		"tf_aws.throttles": int64(1),
+	}); diff != "" {
+		t.Errorf("unexpected API call span attributes diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(attributes(operation.Attributes, "terraform.resource_id", "tf_aws.api_calls", "tf_aws.api_retries", "tf_aws.api_throttles"), map[string]any{
+		"terraform.resource_id": "test-id",
+		"tf_aws.api_calls":      int64(1),
+		"tf_aws.api_retries":    int64(1),
+		"tf_aws.api_throttles":  int64(1),
+	}); diff != "" {
+		t.Errorf("unexpected operation span attributes diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAWSClientStartSpanNotEnabled(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+
+	var client *AWSClient
+	got, endSpan := client.StartSpan(ctx, "aws_test", "Read")
+	endSpan("", false)
+
+	if got != ctx {
+		t.Error("expected unchanged Context")
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if tracerProvider, err := newTracerProvider(ctx, &TracingConfig{}); err != nil || tracerProvider != nil {
+		t.Errorf("got %v, %v; want nil tracer provider for empty configuration", tracerProvider, err)
+	}
+
+	if _, err := newTracerProvider(ctx, &TracingConfig{Endpoint: "localhost:4318"}); err == nil {
+		t.Error("expected error for endpoint without scheme")
+	}
+
+	file := filepath.Join(t.TempDir(), "trace.json")
+	tracerProvider, err := newTracerProvider(ctx, &TracingConfig{File: file})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, span := tracerProvider.Tracer(tracerName).Start(ctx, "test")
+	span.End()
+
+	// Spans are exported in batches.
+	client := &AWSClient{tracerProvider: tracerProvider}
+	if err := client.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(b), `"Name":"test"`) {
+		t.Errorf("span not written to trace file: %s", b)
+	}
+}
+
+func TestAWSClientShutdownNotEnabled(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+
+	var client *AWSClient
+	if err := client.Shutdown(ctx); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := (&AWSClient{}).Shutdown(ctx); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
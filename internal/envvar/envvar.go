@@ -84,6 +84,28 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for running resource sweepers and filtering and reporting the resources they delete
+const (
+	// List the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Only delete resources created at least this long ago, e.g. 2h
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// The maximum number of sweepers run concurrently across all Regions.
+	// Defaults to 4.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Path of a file of ARNs, one per line, of resources that are never deleted
+	SweepProtectedARNsFile = "TF_AWS_SWEEP_PROTECTED_ARNS_FILE"
+
+	// Path of a JSON report of the resources swept, skipped and failed in each region
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// Only delete resources with all these comma-separated tags, e.g. tf-acc-test or Owner=ci
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// TokenBucket limits the rate of executions.
+// Unlike Semaphore, which limits concurrent executions, TokenBucket allows bursts of up to its capacity
+// and otherwise limits executions to a fixed number per second.
+type TokenBucket struct {
+	burst  float64
+	last   time.Time
+	lock   sync.Mutex
+	now    func() time.Time
+	rate   float64 // Tokens per second.
+	tokens float64
+}
+
+// NewTokenBucket returns a full TokenBucket refilled at the specified rate (tokens per second) up to burst tokens.
+// A burst of less than 1 defaults to the rate rounded up.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = max(int(math.Ceil(rate)), 1)
+	}
+
+	return &TokenBucket{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// Wait blocks until a token is available or the context is done, returning how long it waited.
+func (b *TokenBucket) Wait(ctx context.Context) (time.Duration, error) {
+	delay := b.reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		b.cancel()
+		return 0, ctx.Err()
+	}
+}
+
+// reserve takes a token, possibly going into debt, and returns how long until that token is available.
+func (b *TokenBucket) reserve() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token.
+func (b *TokenBucket) cancel() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
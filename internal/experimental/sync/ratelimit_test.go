@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := NewTokenBucket(2, 2)
+	b.now = func() time.Time { return now }
+
+	// Burst.
+	for i := range 2 {
+		if got := b.reserve(); got != 0 {
+			t.Errorf("reserve %d: got %s, want 0", i, got)
+		}
+	}
+
+	// Bucket is empty, 2 tokens per second.
+	if got, want := b.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got, want := b.reserve(), time.Second; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// Refill, never above burst.
+	now = now.Add(time.Minute)
+	for i := range 2 {
+		if got := b.reserve(); got != 0 {
+			t.Errorf("reserve %d after refill: got %s, want 0", i, got)
+		}
+	}
+	if got := b.reserve(); got <= 0 {
+		t.Errorf("got %s, want > 0", got)
+	}
+}
+
+func TestTokenBucketDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	if got, want := NewTokenBucket(2.5, 0).burst, 3.0; got != want {
+		t.Errorf("got %f, want %f", got, want)
+	}
+	if got, want := NewTokenBucket(0.1, 0).burst, 1.0; got != want {
+		t.Errorf("got %f, want %f", got, want)
+	}
+}
+
+func TestTokenBucketWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	b := NewTokenBucket(0.001, 1)
+	if _, err := b.Wait(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if _, err := b.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
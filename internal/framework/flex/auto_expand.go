@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"iter"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -1152,6 +1153,11 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if union, ok := lookupUnion(valTo.Type()); ok {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, union, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1164,18 +1170,49 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 
 	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
-		_, fromFieldOpts := autoflexTags(fromField)
+		fromNameOverride, fromFieldOpts := autoflexTags(fromField)
+		fromFieldVal := valFrom.FieldByIndex(fromField.Index)
+
+		var toFieldName string
+		var toFieldVal reflect.Value
+		toFieldPath := targetPath
+		if fromNameOverride != "" {
+			// The source field maps to the named, possibly nested, target field.
+			segments := strings.Split(fromNameOverride, ".")
+			if _, ok := fieldTypeByPath(typeTo, segments); !ok {
+				tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+					logAttrKeySourceFieldname: fromFieldName,
+				})
+				continue
+			}
 
-		toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
-		if !ok {
-			// Corresponding field not found in to.
-			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
-				logAttrKeySourceFieldname: fromFieldName,
-			})
-			continue
+			// Don't allocate intermediate structs for a value that won't be set.
+			if v, ok := fromFieldVal.Interface().(attr.Value); ok && len(segments) > 1 && (v.IsNull() || v.IsUnknown()) {
+				tflog.SubsystemTrace(ctx, subsystemName, "Skipping null source field", map[string]any{
+					logAttrKeySourceFieldname: fromFieldName,
+					logAttrKeyTargetFieldname: fromNameOverride,
+				})
+				continue
+			}
+
+			toFieldName = fromNameOverride
+			toFieldVal = allocateFieldByPath(valTo, segments)
+			for _, segment := range segments {
+				toFieldPath = toFieldPath.AtName(segment)
+			}
+		} else {
+			toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+			if !ok {
+				// Corresponding field not found in to.
+				tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+					logAttrKeySourceFieldname: fromFieldName,
+				})
+				continue
+			}
+			toFieldName = toField.Name
+			toFieldVal = valTo.FieldByIndex(toField.Index)
+			toFieldPath = toFieldPath.AtName(toFieldName)
 		}
-		toFieldName := toField.Name
-		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			// Corresponding field value can't be changed.
 			tflog.SubsystemDebug(ctx, subsystemName, "Field cannot be set", map[string]any{
@@ -1190,11 +1227,19 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			logAttrKeyTargetFieldname: toFieldName,
 		})
 
+		if converter := fromFieldOpts.Converter(); converter != "" {
+			diags.Append(expandWithConverter(ctx, converter, sourcePath.AtName(fromFieldName), fromFieldVal, toFieldPath, toFieldVal)...)
+			if diags.HasError() {
+				break
+			}
+			continue
+		}
+
 		opts := fieldOpts{
 			legacy: fromFieldOpts.Legacy(),
 		}
 
-		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), fromFieldVal, toFieldPath, toFieldVal, opts)...)
 		if diags.HasError() {
 			break
 		}
@@ -1214,13 +1259,19 @@ func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpti
 				continue
 			}
 
-			fromNameOverride, _ := autoflexTags(field)
+			fromNameOverride, fromOpts := autoflexTags(field)
 			if fromNameOverride == "-" {
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 					logAttrKeySourceFieldname: fieldName,
 				})
 				continue
 			}
+			if fromOpts.NoExpand() {
+				tflog.SubsystemTrace(ctx, subsystemName, "Skipping noexpand source field", map[string]any{
+					logAttrKeySourceFieldname: fieldName,
+				})
+				continue
+			}
 
 			if fieldName == mapBlockKeyFieldName {
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
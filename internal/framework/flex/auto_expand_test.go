@@ -4841,6 +4841,338 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"single list Source with primitive member and single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberString{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsUnionType("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1", "awsUnionInterfaceMemberString", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"single list Source with nested object member and single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringNull(),
+						Struct: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberStruct{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsUnionType("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[0]", "Struct", reflect.TypeFor[tfUnion](), "Field1", "awsUnionInterfaceMemberStruct", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].Struct", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Struct[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Struct[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"single list Source with name override and single union Target": {
+			Source: tfListNestedObject[tfUnionNameOverride]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnionNameOverride{
+					{
+						Text:   types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberString{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnionNameOverride]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnionNameOverride]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnionNameOverride]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnionNameOverride]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsUnionType("Field1[0]", reflect.TypeFor[tfUnionNameOverride](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[0]", "Text", reflect.TypeFor[tfUnionNameOverride](), "Field1", "awsUnionInterfaceMemberString", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].Text", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"list Source and union slice Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						String: types.StringNull(),
+						Struct: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnionInterface{
+					&awsUnionInterfaceMemberString{
+						Value: "value1",
+					},
+					&awsUnionInterfaceMemberStruct{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnionInterface]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnionInterface]()),
+				infoTargetIsUnionType("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1[0]", "awsUnionInterfaceMemberString", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoTargetIsUnionType("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[1]", "Struct", reflect.TypeFor[tfUnion](), "Field1[1]", "awsUnionInterfaceMemberStruct", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[1].Struct", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[1].Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[1].Struct[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[1].Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[1].Struct[0].Field1", reflect.TypeFor[types.String](), "Field1[1].Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"no member set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringNull(),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionNoMemberSet([]string{"string", "struct"}),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsUnionType("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+			},
+		},
+		"multiple members set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionMultipleMembersSet([]string{"string", "struct"}, 2),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsUnionType("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandFieldConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"values": {
+			Source: &tfFieldConverters{
+				Names: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+					types.StringValue("a"),
+					types.StringValue("b"),
+				}),
+				Timestamp: types.StringValue("2024-01-02T03:04:05Z"),
+			},
+			Target: &awsFieldConverters{},
+			WantTarget: &awsFieldConverters{
+				Names:     aws.String("a,b"),
+				Timestamp: aws.Int64(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Unix()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldConverters](), reflect.TypeFor[*awsFieldConverters]()),
+				infoConverting(reflect.TypeFor[tfFieldConverters](), reflect.TypeFor[*awsFieldConverters]()),
+				traceMatchedFields("Names", reflect.TypeFor[tfFieldConverters](), "Names", reflect.TypeFor[*awsFieldConverters]()),
+				infoUsingFieldConverter("Names", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Names", reflect.TypeFor[*string](), FieldConverterCommaSeparated),
+				traceMatchedFields("Timestamp", reflect.TypeFor[tfFieldConverters](), "Timestamp", reflect.TypeFor[*awsFieldConverters]()),
+				infoUsingFieldConverter("Timestamp", reflect.TypeFor[types.String](), "Timestamp", reflect.TypeFor[*int64](), FieldConverterEpochSeconds),
+			},
+		},
+		"null values": {
+			Source: &tfFieldConverters{
+				Names:     fwtypes.NewListValueOfNull[types.String](ctx),
+				Timestamp: types.StringNull(),
+			},
+			Target:     &awsFieldConverters{},
+			WantTarget: &awsFieldConverters{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldConverters](), reflect.TypeFor[*awsFieldConverters]()),
+				infoConverting(reflect.TypeFor[tfFieldConverters](), reflect.TypeFor[*awsFieldConverters]()),
+				traceMatchedFields("Names", reflect.TypeFor[tfFieldConverters](), "Names", reflect.TypeFor[*awsFieldConverters]()),
+				infoUsingFieldConverter("Names", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Names", reflect.TypeFor[*string](), FieldConverterCommaSeparated),
+				traceMatchedFields("Timestamp", reflect.TypeFor[tfFieldConverters](), "Timestamp", reflect.TypeFor[*awsFieldConverters]()),
+				infoUsingFieldConverter("Timestamp", reflect.TypeFor[types.String](), "Timestamp", reflect.TypeFor[*int64](), FieldConverterEpochSeconds),
+			},
+		},
+		"unknown converter": {
+			Source: &tfFieldConverterUnknown{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsSingleStringPointer{},
+			expectedDiags: diag.Diagnostics{
+				diagUnknownFieldConverter("unknown"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldConverterUnknown](), reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConverting(reflect.TypeFor[tfFieldConverterUnknown](), reflect.TypeFor[*awsSingleStringPointer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfFieldConverterUnknown](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandNoExpand(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"noexpand source field": {
+			Source: &tfDirectionOnlyFields{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+			},
+			Target: &awsDirectionOnlyFields{},
+			WantTarget: &awsDirectionOnlyFields{
+				Field2: aws.String("value2"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfDirectionOnlyFields](), reflect.TypeFor[*awsDirectionOnlyFields]()),
+				infoConverting(reflect.TypeFor[tfDirectionOnlyFields](), reflect.TypeFor[*awsDirectionOnlyFields]()),
+				traceSkipNoExpandSourceField(reflect.TypeFor[tfDirectionOnlyFields](), "Field1", reflect.TypeFor[*awsDirectionOnlyFields]()),
+				traceMatchedFields("Field2", reflect.TypeFor[tfDirectionOnlyFields](), "Field2", reflect.TypeFor[*awsDirectionOnlyFields]()),
+				infoConvertingWithPath("Field2", reflect.TypeFor[types.String](), "Field2", reflect.TypeFor[*string]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandNestedPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"values": {
+			Source: &tfNestedPath{
+				Name: types.StringValue("name1"),
+				SubnetIDs: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+					types.StringValue("subnet-1"),
+					types.StringValue("subnet-2"),
+				}),
+				Timeout: types.Int64Value(30),
+			},
+			Target: &awsNestedPath{},
+			WantTarget: &awsNestedPath{
+				Name: aws.String("name1"),
+				Config: &awsNestedPathConfig{
+					Network: &awsNestedPathNetwork{
+						SubnetIds: []string{"subnet-1", "subnet-2"},
+					},
+					Timeout: aws.Int64(30),
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfNestedPath](), reflect.TypeFor[*awsNestedPath]()),
+				infoConverting(reflect.TypeFor[tfNestedPath](), reflect.TypeFor[*awsNestedPath]()),
+				traceMatchedFields("Name", reflect.TypeFor[tfNestedPath](), "Name", reflect.TypeFor[*awsNestedPath]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[types.String](), "Name", reflect.TypeFor[*string]()),
+				traceMatchedFields("SubnetIDs", reflect.TypeFor[tfNestedPath](), "Config.Network.SubnetIds", reflect.TypeFor[*awsNestedPath]()),
+				infoConvertingWithPath("SubnetIDs", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Config.Network.SubnetIds", reflect.TypeFor[[]string]()),
+				traceExpandingWithElementsAs("SubnetIDs", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), 2, "Config.Network.SubnetIds", reflect.TypeFor[[]string]()),
+				traceMatchedFields("Timeout", reflect.TypeFor[tfNestedPath](), "Config.Timeout", reflect.TypeFor[*awsNestedPath]()),
+				infoConvertingWithPath("Timeout", reflect.TypeFor[types.Int64](), "Config.Timeout", reflect.TypeFor[*int64]()),
+			},
+		},
+		"null values": {
+			Source: &tfNestedPath{
+				Name:      types.StringValue("name1"),
+				SubnetIDs: fwtypes.NewListValueOfNull[types.String](ctx),
+				Timeout:   types.Int64Null(),
+			},
+			Target: &awsNestedPath{},
+			WantTarget: &awsNestedPath{
+				Name: aws.String("name1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfNestedPath](), reflect.TypeFor[*awsNestedPath]()),
+				infoConverting(reflect.TypeFor[tfNestedPath](), reflect.TypeFor[*awsNestedPath]()),
+				traceMatchedFields("Name", reflect.TypeFor[tfNestedPath](), "Name", reflect.TypeFor[*awsNestedPath]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[types.String](), "Name", reflect.TypeFor[*string]()),
+				traceSkipNullSourceField(reflect.TypeFor[tfNestedPath](), "SubnetIDs", reflect.TypeFor[*awsNestedPath](), "Config.Network.SubnetIds"),
+				traceSkipNullSourceField(reflect.TypeFor[tfNestedPath](), "Timeout", reflect.TypeFor[*awsNestedPath](), "Config.Timeout"),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
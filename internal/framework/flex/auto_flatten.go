@@ -192,7 +192,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -611,7 +611,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -655,7 +655,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1217,7 +1217,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1239,6 +1239,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		if _, ok := lookupUnion(vFrom.Type()); ok {
+			//
+			// union interface -> types.List(OfObject) or types.Object.
+			//
+			diags.Append(flattenStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -1508,6 +1527,11 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	if union, ok := lookupUnion(valFrom.Type()); ok {
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, union, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -1532,6 +1556,14 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			})
 			continue
 		}
+		if toNameOverride != "" {
+			// Flattened from the named source field below.
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping target field with source path", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+			continue
+		}
 		if toOpts.NoFlatten() {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping noflatten target field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
@@ -1553,20 +1585,87 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			logAttrKeyTargetFieldname: toFieldName,
 		})
 
-		opts := fieldOpts{
-			legacy:    toOpts.Legacy(),
-			omitempty: toOpts.OmitEmpty(),
+		diags.Append(flattenField(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, toOpts, flexer)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Target fields whose `autoflex` struct tag names a, possibly nested, source field.
+	for toField := range tfreflect.ExportedStructFields(typeTo) {
+		toFieldName := toField.Name
+		toNameOverride, toOpts := autoflexTags(toField)
+		if toNameOverride == "" || toNameOverride == "-" || toOpts.NoFlatten() {
+			continue
+		}
+		toFieldVal := valTo.FieldByIndex(toField.Index)
+		if !toFieldVal.CanSet() {
+			continue
+		}
+
+		segments := strings.Split(toNameOverride, ".")
+		if _, ok := fieldTypeByPath(typeFrom, segments); !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+				logAttrKeySourceFieldname: toNameOverride,
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched fields", map[string]any{
+			logAttrKeySourceFieldname: toNameOverride,
+			logAttrKeyTargetFieldname: toFieldName,
+		})
+
+		fromFieldVal := fieldByPath(valFrom, segments)
+		if !fromFieldVal.IsValid() {
+			// An intermediate source struct is nil.
+			tflog.SubsystemTrace(ctx, subsystemName, "Flattening nil source path", map[string]any{
+				logAttrKeySourceFieldname: toNameOverride,
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+			to, ok := toFieldVal.Interface().(attr.Value)
+			if !ok {
+				diags.Append(diagFlatteningTargetDoesNotImplementAttrValue(toFieldVal.Type()))
+				return diags
+			}
+			v, d := convertAttrValue(ctx, nil, to.Type(ctx))
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			toFieldVal.Set(reflect.ValueOf(v))
+			continue
+		}
+
+		fromFieldPath := sourcePath
+		for _, segment := range segments {
+			fromFieldPath = fromFieldPath.AtName(segment)
 		}
 
-		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+		diags.Append(flattenField(ctx, fromFieldPath, fromFieldVal, targetPath.AtName(toFieldName), toFieldVal, toOpts, flexer)...)
 		if diags.HasError() {
-			break
+			return diags
 		}
 	}
 
 	return diags
 }
 
+// flattenField flattens the source field `valFrom` into the target field `valTo`, honoring the target field's `autoflex` struct tag options.
+func flattenField(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, toOpts tagOptions, flexer autoFlexer) diag.Diagnostics {
+	if converter := toOpts.Converter(); converter != "" {
+		return flattenWithConverter(ctx, converter, sourcePath, valFrom, targetPath, valTo)
+	}
+
+	opts := fieldOpts{
+		legacy:    toOpts.Legacy(),
+		omitempty: toOpts.OmitEmpty(),
+	}
+
+	return flexer.convert(ctx, sourcePath, valFrom, targetPath, valTo, opts)
+}
+
 func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
@@ -4781,6 +4781,297 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nil union Source and list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"primitive member Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberString{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionTypeMember("Field1", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1", "awsUnionInterfaceMemberString", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.String", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nested object member Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberStruct{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringNull(),
+						Struct: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionTypeMember("Field1", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1", "awsUnionInterfaceMemberStruct", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1", "Struct", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Struct", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Struct", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Struct.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"primitive member Source and name override Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberString{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnionNameOverride]{},
+			WantTarget: &tfListNestedObject[tfUnionNameOverride]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnionNameOverride{
+					{
+						Text:   types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnionNameOverride]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnionNameOverride]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnionNameOverride]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnionNameOverride]]()),
+				infoSourceIsUnionTypeMember("Field1", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1", reflect.TypeFor[*tfUnionNameOverride]()),
+				traceMatchedUnionMember("Field1", "awsUnionInterfaceMemberString", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1", "Text", reflect.TypeFor[*tfUnionNameOverride]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.Text", reflect.TypeFor[types.String]()),
+			},
+		},
+		"union slice Source and list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnionInterface{
+					&awsUnionInterfaceMemberString{
+						Value: "value1",
+					},
+					&awsUnionInterfaceMemberStruct{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Struct: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						String: types.StringNull(),
+						Struct: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnionInterface](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionTypeMember("Field1[0]", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[0]", "awsUnionInterfaceMemberString", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1[0]", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].String", reflect.TypeFor[types.String]()),
+				infoSourceIsUnionTypeMember("Field1[1]", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[1]", "awsUnionInterfaceMemberStruct", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1[1]", "Struct", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Struct", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1].Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Struct", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Value.Field1", reflect.TypeFor[string](), "Field1[1].Struct.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenFieldConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"values": {
+			Source: &awsFieldConverters{
+				Names:     aws.String("a,b"),
+				Timestamp: aws.Int64(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Unix()),
+			},
+			Target: &tfFieldConverters{},
+			WantTarget: &tfFieldConverters{
+				Names: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+					types.StringValue("a"),
+					types.StringValue("b"),
+				}),
+				Timestamp: types.StringValue("2024-01-02T03:04:05Z"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsFieldConverters](), reflect.TypeFor[*tfFieldConverters]()),
+				infoConverting(reflect.TypeFor[awsFieldConverters](), reflect.TypeFor[*tfFieldConverters]()),
+				traceMatchedFields("Names", reflect.TypeFor[awsFieldConverters](), "Names", reflect.TypeFor[*tfFieldConverters]()),
+				infoUsingFieldConverter("Names", reflect.TypeFor[*string](), "Names", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), FieldConverterCommaSeparated),
+				traceMatchedFields("Timestamp", reflect.TypeFor[awsFieldConverters](), "Timestamp", reflect.TypeFor[*tfFieldConverters]()),
+				infoUsingFieldConverter("Timestamp", reflect.TypeFor[*int64](), "Timestamp", reflect.TypeFor[types.String](), FieldConverterEpochSeconds),
+			},
+		},
+		"nil values": {
+			Source: &awsFieldConverters{},
+			Target: &tfFieldConverters{},
+			WantTarget: &tfFieldConverters{
+				Names:     fwtypes.NewListValueOfNull[types.String](ctx),
+				Timestamp: types.StringNull(),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsFieldConverters](), reflect.TypeFor[*tfFieldConverters]()),
+				infoConverting(reflect.TypeFor[awsFieldConverters](), reflect.TypeFor[*tfFieldConverters]()),
+				traceMatchedFields("Names", reflect.TypeFor[awsFieldConverters](), "Names", reflect.TypeFor[*tfFieldConverters]()),
+				infoUsingFieldConverter("Names", reflect.TypeFor[*string](), "Names", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), FieldConverterCommaSeparated),
+				traceMatchedFields("Timestamp", reflect.TypeFor[awsFieldConverters](), "Timestamp", reflect.TypeFor[*tfFieldConverters]()),
+				infoUsingFieldConverter("Timestamp", reflect.TypeFor[*int64](), "Timestamp", reflect.TypeFor[types.String](), FieldConverterEpochSeconds),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenNoFlatten(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"noflatten target field": {
+			Source: &awsDirectionOnlyFields{
+				Field1: aws.String("value1"),
+				Field2: aws.String("value2"),
+			},
+			Target: &tfDirectionOnlyFields{},
+			WantTarget: &tfDirectionOnlyFields{
+				Field1: types.StringValue("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsDirectionOnlyFields](), reflect.TypeFor[*tfDirectionOnlyFields]()),
+				infoConverting(reflect.TypeFor[awsDirectionOnlyFields](), reflect.TypeFor[*tfDirectionOnlyFields]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsDirectionOnlyFields](), "Field1", reflect.TypeFor[*tfDirectionOnlyFields]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				traceSkipNoFlattenTargetField(reflect.TypeFor[awsDirectionOnlyFields](), "Field2", reflect.TypeFor[*tfDirectionOnlyFields](), "Field2"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenNestedPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"values": {
+			Source: &awsNestedPath{
+				Name: aws.String("name1"),
+				Config: &awsNestedPathConfig{
+					Network: &awsNestedPathNetwork{
+						SubnetIds: []string{"subnet-1", "subnet-2"},
+					},
+					Timeout: aws.Int64(30),
+				},
+			},
+			Target: &tfNestedPath{},
+			WantTarget: &tfNestedPath{
+				Name: types.StringValue("name1"),
+				SubnetIDs: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+					types.StringValue("subnet-1"),
+					types.StringValue("subnet-2"),
+				}),
+				Timeout: types.Int64Value(30),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsNestedPath](), reflect.TypeFor[*tfNestedPath]()),
+				infoConverting(reflect.TypeFor[awsNestedPath](), reflect.TypeFor[*tfNestedPath]()),
+				traceMatchedFields("Name", reflect.TypeFor[awsNestedPath](), "Name", reflect.TypeFor[*tfNestedPath]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[*string](), "Name", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsNestedPath](), "Config", reflect.TypeFor[*tfNestedPath]()),
+				traceMatchedFields("Config.Network.SubnetIds", reflect.TypeFor[awsNestedPath](), "SubnetIDs", reflect.TypeFor[*tfNestedPath]()),
+				infoConvertingWithPath("Config.Network.SubnetIds", reflect.TypeFor[[]string](), "SubnetIDs", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+				traceFlatteningWithListValue("Config.Network.SubnetIds", reflect.TypeFor[[]string](), 2, "SubnetIDs", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+				traceMatchedFields("Config.Timeout", reflect.TypeFor[awsNestedPath](), "Timeout", reflect.TypeFor[*tfNestedPath]()),
+				infoConvertingWithPath("Config.Timeout", reflect.TypeFor[*int64](), "Timeout", reflect.TypeFor[types.Int64]()),
+			},
+		},
+		"nil intermediate struct": {
+			Source: &awsNestedPath{
+				Name: aws.String("name1"),
+			},
+			Target: &tfNestedPath{},
+			WantTarget: &tfNestedPath{
+				Name:      types.StringValue("name1"),
+				SubnetIDs: fwtypes.NewListValueOfNull[types.String](ctx),
+				Timeout:   types.Int64Null(),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsNestedPath](), reflect.TypeFor[*tfNestedPath]()),
+				infoConverting(reflect.TypeFor[awsNestedPath](), reflect.TypeFor[*tfNestedPath]()),
+				traceMatchedFields("Name", reflect.TypeFor[awsNestedPath](), "Name", reflect.TypeFor[*tfNestedPath]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[*string](), "Name", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsNestedPath](), "Config", reflect.TypeFor[*tfNestedPath]()),
+				traceMatchedFields("Config.Network.SubnetIds", reflect.TypeFor[awsNestedPath](), "SubnetIDs", reflect.TypeFor[*tfNestedPath]()),
+				traceFlatteningNilSourcePath(reflect.TypeFor[awsNestedPath](), "Config.Network.SubnetIds", reflect.TypeFor[*tfNestedPath](), "SubnetIDs"),
+				traceMatchedFields("Config.Timeout", reflect.TypeFor[awsNestedPath](), "Timeout", reflect.TypeFor[*tfNestedPath]()),
+				traceFlatteningNilSourcePath(reflect.TypeFor[awsNestedPath](), "Config.Timeout", reflect.TypeFor[*tfNestedPath](), "Timeout"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
@@ -192,3 +192,56 @@ func valueType(v reflect.Value) reflect.Type {
 	}
 	return v.Type()
 }
+
+// fieldTypeByPath returns the type of the field at the specified path of field names.
+// Pointers to structs are followed.
+func fieldTypeByPath(typ reflect.Type, segments []string) (reflect.Type, bool) {
+	for _, segment := range segments {
+		for typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		field, ok := typ.FieldByName(segment)
+		if !ok || !field.IsExported() {
+			return nil, false
+		}
+		typ = field.Type
+	}
+
+	return typ, true
+}
+
+// allocateFieldByPath returns the field at the specified, valid, path of field names.
+// Nil pointers to intermediate structs are allocated.
+func allocateFieldByPath(v reflect.Value, segments []string) reflect.Value {
+	for _, segment := range segments {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.FieldByName(segment)
+	}
+
+	return v
+}
+
+// fieldByPath returns the field at the specified, valid, path of field names.
+// If an intermediate pointer is nil, an invalid Value is returned.
+func fieldByPath(v reflect.Value, segments []string) reflect.Value {
+	for _, segment := range segments {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.FieldByName(segment)
+	}
+
+	return v
+}
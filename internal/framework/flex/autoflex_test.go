@@ -558,6 +558,91 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+func init() {
+	RegisterUnion[awsUnionInterface](
+		&awsUnionInterfaceMemberString{},
+		&awsUnionInterfaceMemberStruct{},
+	)
+}
+
+type tfUnion struct {
+	String types.String                                         `tfsdk:"string"`
+	Struct fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"struct"`
+}
+
+type tfUnionNameOverride struct {
+	Text   types.String                                         `tfsdk:"text" autoflex:"String"`
+	Struct fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"struct"`
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnionInterface
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnionInterface
+}
+
+type awsUnionInterface interface {
+	isAWSUnionInterface()
+}
+
+type awsUnionInterfaceMemberString struct {
+	Value string
+}
+
+func (t *awsUnionInterfaceMemberString) isAWSUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionInterfaceMemberStruct struct {
+	Value awsSingleStringValue
+}
+
+func (t *awsUnionInterfaceMemberStruct) isAWSUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
+type tfFieldConverters struct {
+	Names     fwtypes.ListValueOf[types.String] `tfsdk:"names" autoflex:",converter=commaseparated"`
+	Timestamp types.String                      `tfsdk:"timestamp" autoflex:",converter=epochseconds"`
+}
+
+type awsFieldConverters struct {
+	Names     *string
+	Timestamp *int64
+}
+
+type tfFieldConverterUnknown struct {
+	Field1 types.String `tfsdk:"field1" autoflex:",converter=unknown"`
+}
+
+type tfDirectionOnlyFields struct {
+	Field1 types.String `tfsdk:"field1" autoflex:",noexpand"`
+	Field2 types.String `tfsdk:"field2" autoflex:",noflatten"`
+}
+
+type awsDirectionOnlyFields struct {
+	Field1 *string
+	Field2 *string
+}
+
+type tfNestedPath struct {
+	Name      types.String                      `tfsdk:"name"`
+	SubnetIDs fwtypes.ListValueOf[types.String] `tfsdk:"subnet_ids" autoflex:"Config.Network.SubnetIds"`
+	Timeout   types.Int64                       `tfsdk:"timeout" autoflex:"Config.Timeout"`
+}
+
+type awsNestedPath struct {
+	Name   *string
+	Config *awsNestedPathConfig
+}
+
+type awsNestedPathConfig struct {
+	Network *awsNestedPathNetwork
+	Timeout *int64
+}
+
+type awsNestedPathNetwork struct {
+	SubnetIds []string
+}
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FieldConverter converts a single field between its Terraform Plugin Framework and AWS API representations.
+// Field converters are registered by name with RegisterFieldConverter and referenced from a model field's
+// `autoflex` struct tag, e.g. `autoflex:",converter=epochseconds"`.
+type FieldConverter interface {
+	// Expand returns the AWS API value for the non-null, known Plugin Framework value `from`.
+	// The returned value must be convertible to `targetType` or to its element type if `targetType` is a pointer.
+	Expand(ctx context.Context, from attr.Value, targetType reflect.Type) (any, diag.Diagnostics)
+	// Flatten returns the Plugin Framework value for the AWS API value `from`.
+	// The returned value is converted to `targetType` via its Terraform value.
+	Flatten(ctx context.Context, from reflect.Value, targetType attr.Type) (attr.Value, diag.Diagnostics)
+}
+
+const (
+	// FieldConverterCommaSeparated converts between a List or Set of String and a comma-separated string.
+	FieldConverterCommaSeparated = "commaseparated"
+	// FieldConverterEpochSeconds converts between an RFC 3339 timestamp string and a number of seconds since the Unix epoch.
+	FieldConverterEpochSeconds = "epochseconds"
+)
+
+var fieldConverters sync.Map // map[string]FieldConverter
+
+func init() {
+	RegisterFieldConverter(FieldConverterCommaSeparated, commaSeparatedConverter{})
+	RegisterFieldConverter(FieldConverterEpochSeconds, epochSecondsConverter{})
+}
+
+// RegisterFieldConverter registers a named field converter.
+// Registering a converter with the name of an existing converter replaces it.
+func RegisterFieldConverter(name string, converter FieldConverter) {
+	fieldConverters.Store(name, converter)
+}
+
+func lookupFieldConverter(name string) (FieldConverter, bool) {
+	if v, ok := fieldConverters.Load(name); ok {
+		return v.(FieldConverter), true
+	}
+
+	return nil, false
+}
+
+// expandWithConverter expands `vFrom` into `vTo` using the named field converter.
+func expandWithConverter(ctx context.Context, name string, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourceType, fullTypeName(valueType(vFrom)))
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetType, fullTypeName(valueType(vTo)))
+
+	converter, ok := lookupFieldConverter(name)
+	if !ok {
+		diags.Append(diagUnknownFieldConverter(name))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using field converter", map[string]any{
+		logAttrKeyConverter: name,
+	})
+
+	from, ok := vFrom.Interface().(attr.Value)
+	if !ok {
+		diags.Append(diagExpandingSourceDoesNotImplementAttrValue(vFrom.Type()))
+		return diags
+	}
+
+	if from.IsNull() || from.IsUnknown() {
+		return diags
+	}
+
+	to, d := converter.Expand(ctx, from, vTo.Type())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to == nil {
+		return diags
+	}
+
+	v := reflect.ValueOf(to)
+	switch tTo := vTo.Type(); {
+	case v.Type().AssignableTo(tTo):
+		vTo.Set(v)
+	case v.Type().ConvertibleTo(tTo):
+		vTo.Set(v.Convert(tTo))
+	case tTo.Kind() == reflect.Pointer && v.Type().ConvertibleTo(tTo.Elem()):
+		p := reflect.New(tTo.Elem())
+		p.Elem().Set(v.Convert(tTo.Elem()))
+		vTo.Set(p)
+	default:
+		diags.Append(diagCannotBeAssigned(v.Type(), tTo))
+	}
+
+	return diags
+}
+
+// flattenWithConverter flattens `vFrom` into `vTo` using the named field converter.
+func flattenWithConverter(ctx context.Context, name string, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourceType, fullTypeName(valueType(vFrom)))
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetType, fullTypeName(valueType(vTo)))
+
+	converter, ok := lookupFieldConverter(name)
+	if !ok {
+		diags.Append(diagUnknownFieldConverter(name))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using field converter", map[string]any{
+		logAttrKeyConverter: name,
+	})
+
+	to, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.Append(diagFlatteningTargetDoesNotImplementAttrValue(vTo.Type()))
+		return diags
+	}
+	tTo := to.Type(ctx)
+
+	v, d := converter.Flatten(ctx, vFrom, tTo)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	v, d = convertAttrValue(ctx, v, tTo)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(v))
+
+	return diags
+}
+
+// convertAttrValue converts `v` to a value of type `typ` via its Terraform value.
+// A nil `v` is converted to a null value.
+func convertAttrValue(ctx context.Context, v attr.Value, typ attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfValue := tftypes.NewValue(typ.TerraformType(ctx), nil)
+	if v != nil {
+		var err error
+		tfValue, err = v.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Converting value", err.Error())
+			return nil, diags
+		}
+	}
+
+	v, err := typ.ValueFromTerraform(ctx, tfValue)
+	if err != nil {
+		diags.AddError("Converting value", err.Error())
+		return nil, diags
+	}
+
+	return v, diags
+}
+
+// commaSeparatedConverter converts between a List or Set of String and a comma-separated string.
+type commaSeparatedConverter struct{}
+
+func (commaSeparatedConverter) Expand(ctx context.Context, from attr.Value, targetType reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := from.(valueWithElementsAs)
+	if !ok {
+		diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(from), targetType))
+		return nil, diags
+	}
+
+	var elems []string
+	diags.Append(v.ElementsAs(ctx, &elems, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return strings.Join(elems, ","), diags
+}
+
+func (commaSeparatedConverter) Flatten(ctx context.Context, from reflect.Value, targetType attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			return nil, diags
+		}
+		from = from.Elem()
+	}
+	if from.Kind() != reflect.String {
+		diags.Append(DiagFlatteningIncompatibleTypes(from.Type(), reflect.TypeOf(targetType)))
+		return nil, diags
+	}
+
+	var elems []string
+	if s := from.String(); s != "" {
+		elems = strings.Split(s, ",")
+	}
+
+	if _, ok := targetType.(basetypes.SetTypable); ok {
+		v, d := types.SetValueFrom(ctx, types.StringType, elems)
+		diags.Append(d...)
+		return v, diags
+	}
+
+	v, d := types.ListValueFrom(ctx, types.StringType, elems)
+	diags.Append(d...)
+	return v, diags
+}
+
+// epochSecondsConverter converts between an RFC 3339 timestamp string and a number of seconds since the Unix epoch.
+type epochSecondsConverter struct{}
+
+func (epochSecondsConverter) Expand(ctx context.Context, from attr.Value, targetType reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := from.(basetypes.StringValuable)
+	if !ok {
+		diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(from), targetType))
+		return nil, diags
+	}
+
+	s, d := v.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, s.ValueString())
+	if err != nil {
+		diags.AddError("Invalid RFC 3339 timestamp", err.Error())
+		return nil, diags
+	}
+
+	return t.Unix(), diags
+}
+
+func (epochSecondsConverter) Flatten(ctx context.Context, from reflect.Value, targetType attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			return nil, diags
+		}
+		from = from.Elem()
+	}
+	if !from.CanInt() {
+		diags.Append(DiagFlatteningIncompatibleTypes(from.Type(), reflect.TypeOf(targetType)))
+		return nil, diags
+	}
+
+	return types.StringValue(time.Unix(from.Int(), 0).UTC().Format(time.RFC3339)), diags
+}
+
+func diagUnknownFieldConverter(name string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field converter %q is not registered", name),
+	)
+}
@@ -24,7 +24,8 @@ const (
 	logAttrKeyTargetFieldname = "autoflex.target.fieldname"
 	logAttrKeyTargetPath      = "autoflex.target.path"
 
-	logAttrKeyError = "error"
+	logAttrKeyConverter = "autoflex.converter"
+	logAttrKeyError     = "error"
 )
 
 const (
@@ -49,3 +49,20 @@ func (o tagOptions) OmitEmpty() bool {
 func (o tagOptions) NoFlatten() bool {
 	return o.Contains("noflatten")
 }
+
+func (o tagOptions) NoExpand() bool {
+	return o.Contains("noexpand")
+}
+
+// Converter returns the name of the field converter specified by a "converter=<name>" option, if any.
+func (o tagOptions) Converter() string {
+	s := string(o)
+	for s != "" {
+		var name string
+		name, s, _ = strings.Cut(s, ",")
+		if v, ok := strings.CutPrefix(name, "converter="); ok {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// AWS SDK for Go v2 union types are interfaces implemented by a member struct per alternative, e.g.
+//
+//	type PromptTemplateConfiguration interface { isPromptTemplateConfiguration() }
+//	type PromptTemplateConfigurationMemberChat struct { Value ChatPromptTemplateConfiguration }
+//	type PromptTemplateConfigurationMemberText struct { Value TextPromptTemplateConfiguration }
+//
+// The Terraform model for a union is a struct with a field per member, e.g.
+//
+//	type promptTemplateConfigurationModel struct {
+//		Chat fwtypes.ListNestedObjectValueOf[chatPromptTemplateConfigurationModel] `tfsdk:"chat"`
+//		Text fwtypes.ListNestedObjectValueOf[textPromptTemplateConfigurationModel] `tfsdk:"text"`
+//	}
+//
+// exactly one of which must be set.
+// Model fields are matched to members by name, the member type's name with the "<Interface>Member" prefix removed,
+// or by `autoflex` struct tag name override.
+
+// unionMemberFieldName is the name of the field holding a union member's value.
+const unionMemberFieldName = "Value"
+
+var unions sync.Map // map[reflect.Type]*unionType, keyed by both interface type and member struct types.
+
+type unionType struct {
+	typ     reflect.Type
+	members []unionMember
+}
+
+type unionMember struct {
+	name string
+	typ  reflect.Type // The member struct type.
+}
+
+// RegisterUnion registers the member types of the AWS SDK for Go v2 union type T so that
+// AutoFlex can expand and flatten T without a hand-written Expander and Flattener.
+// Members are specified by example, e.g.
+//
+//	flex.RegisterUnion[awstypes.PromptTemplateConfiguration](
+//		&awstypes.PromptTemplateConfigurationMemberChat{},
+//		&awstypes.PromptTemplateConfigurationMemberText{},
+//	)
+//
+// RegisterUnion panics if T is not an interface type or a member is not a pointer to a struct with a Value field.
+func RegisterUnion[T any](members ...T) {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("RegisterUnion: %s is not an interface type", fullTypeName(typ)))
+	}
+
+	union := &unionType{
+		typ: typ,
+	}
+
+	for _, member := range members {
+		memberType := reflect.TypeOf(member)
+		if memberType == nil || memberType.Kind() != reflect.Pointer || memberType.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("RegisterUnion: member %s of %s is not a pointer to a struct", fullTypeName(memberType), fullTypeName(typ)))
+		}
+		memberType = memberType.Elem()
+		if _, ok := memberType.FieldByName(unionMemberFieldName); !ok {
+			panic(fmt.Sprintf("RegisterUnion: member %s of %s has no %s field", fullTypeName(memberType), fullTypeName(typ), unionMemberFieldName))
+		}
+
+		name, _ := strings.CutPrefix(memberType.Name(), typ.Name()+"Member")
+		union.members = append(union.members, unionMember{
+			name: name,
+			typ:  memberType,
+		})
+		unions.Store(memberType, union)
+	}
+
+	unions.Store(typ, union)
+}
+
+// lookupUnion returns the registered union type for the specified interface or member type.
+func lookupUnion(typ reflect.Type) (*unionType, bool) {
+	if typ == nil {
+		return nil, false
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if v, ok := unions.Load(typ); ok {
+		return v.(*unionType), true
+	}
+
+	return nil, false
+}
+
+// memberForType returns the member with the specified struct type.
+func (u *unionType) memberForType(typ reflect.Type) (unionMember, bool) {
+	for _, member := range u.members {
+		if member.typ == typ {
+			return member, true
+		}
+	}
+
+	return unionMember{}, false
+}
+
+// modelField returns the union model field corresponding to the specified member.
+func (u *unionType) modelField(typ reflect.Type, member unionMember, opts AutoFlexOptions) (reflect.StructField, bool) {
+	for field := range tfreflect.ExportedStructFields(typ) {
+		if opts.isIgnoredField(field.Name) {
+			continue
+		}
+
+		name := field.Name
+		if nameOverride, _ := autoflexTags(field); nameOverride == "-" {
+			continue
+		} else if nameOverride != "" {
+			name = nameOverride
+		}
+
+		if strings.EqualFold(name, member.name) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// expandUnion expands the union model struct `valFrom` into the union interface value `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, union *unionType, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Target is a union type")
+
+	typeFrom := valFrom.Type()
+
+	var set []unionMember
+	var fields []reflect.StructField
+	var names []string
+	for _, member := range union.members {
+		field, ok := union.modelField(typeFrom, member, flexer.getOptions())
+		if !ok {
+			continue
+		}
+		names = append(names, tfsdkName(field))
+
+		v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		set = append(set, member)
+		fields = append(fields, field)
+	}
+
+	switch len(set) {
+	case 0:
+		diags.Append(diagExpandingUnionNoMemberSet(names))
+		return diags
+
+	case 1:
+
+	default:
+		diags.Append(diagExpandingUnionMultipleMembersSet(names, len(set)))
+		return diags
+	}
+
+	member, field := set[0], fields[0]
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: field.Name,
+		logAttrKeyTargetFieldname: member.typ.Name(),
+	})
+
+	to := reflect.New(member.typ)
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(field.Name), valFrom.FieldByIndex(field.Index), targetPath.AtName(unionMemberFieldName), to.Elem().FieldByName(unionMemberFieldName), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(to)
+
+	return diags
+}
+
+// flattenUnion flattens the union member struct `valFrom` into the union model struct `valTo`.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, union *unionType, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Source is a union type member")
+
+	member, ok := union.memberForType(valFrom.Type())
+	if !ok {
+		diags.Append(DiagFlatteningIncompatibleTypes(valFrom.Type(), valTo.Type()))
+		return diags
+	}
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	field, ok := union.modelField(valTo.Type(), member, flexer.getOptions())
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: member.typ.Name(),
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: member.typ.Name(),
+		logAttrKeyTargetFieldname: field.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberFieldName), valFrom.FieldByName(unionMemberFieldName), targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts{})...)
+
+	return diags
+}
+
+// tfsdkName returns the Terraform attribute name of the specified model field.
+func tfsdkName(field reflect.StructField) string {
+	if v := field.Tag.Get("tfsdk"); v != "" {
+		return v
+	}
+
+	return field.Name
+}
+
+func diagExpandingUnionNoMemberSet(names []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("No attribute specified when one (and only one) of [%s] is required", strings.Join(names, ",")),
+	)
+}
+
+func diagExpandingUnionMultipleMembersSet(names []string, n int) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("%d attributes specified when one (and only one) of [%s] is required", n, strings.Join(names, ",")),
+	)
+}
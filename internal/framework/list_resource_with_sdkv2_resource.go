@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ ListResourceWithSDKv2Resourcer = &ListResourceWithSDKv2Resource{}
+
+// ListResourceWithSDKv2Resourcer is implemented by list resources for Plugin SDK resources.
+// The provider sets the SDK resource's (fully wrapped) schema and the resource's Identity specification.
+type ListResourceWithSDKv2Resourcer interface {
+	SetResourceSchema(*schema.Resource)
+	SetIdentitySpec(inttypes.Identity)
+}
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a list resource for a Plugin SDK resource.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	identitySpec   inttypes.Identity
+	resourceSchema *schema.Resource
+}
+
+// Metadata should return the full name of the list resource, such as
+// examplecloud_thing.
+func (*ListResourceWithSDKv2Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method is implemented in the wrappers.
+	panic("not implemented") // lintignore:R009
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined list resource type.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+func (l *ListResourceWithSDKv2Resource) SetIdentitySpec(identitySpec inttypes.Identity) {
+	l.identitySpec = identitySpec
+}
+
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(resourceSchema *schema.Resource) {
+	l.resourceSchema = resourceSchema
+}
+
+// RawV5Schemas returns the Plugin SDK resource's resource and identity schemas.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resourceSchema.ProtoSchema(ctx)()
+	if f := l.resourceSchema.ProtoIdentitySchema(ctx); f != nil {
+		response.ProtoV5IdentitySchema = f()
+	}
+}
+
+// ListResourceConfigSchema returns an empty list configuration schema.
+// List resources that support filtering should override this method.
+func (l *ListResourceWithSDKv2Resource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, _ *list.ListResourceSchemaResponse) {
+}
+
+// ResourceData returns a new, empty ResourceData for the Plugin SDK resource.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resourceSchema.Data(&terraform.InstanceState{})
+}
+
+// SetResult populates a list result from a Plugin SDK ResourceData.
+// The ResourceData must have its ID and any identity attributes set.
+// If the full resource has been requested, the Plugin SDK resource's Read handler is called,
+// running all interceptors (e.g. transparent tagging) just as for a refresh.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, awsClient *conns.AWSClient, includeResource bool, d *schema.ResourceData, result *list.ListResult) {
+	if _, ok := l.resourceSchema.SchemaMap()[names.AttrRegion]; ok {
+		if err := d.Set(names.AttrRegion, awsClient.Region(ctx)); err != nil {
+			result.Diagnostics.AddError("Setting Region", err.Error())
+			return
+		}
+	}
+
+	if includeResource {
+		result.Diagnostics.Append(fromSDKDiagnostics(l.resourceSchema.ReadWithoutTimeout(ctx, d, awsClient))...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		if d.Id() == "" {
+			// The resource was deleted between being listed and being read.
+			result.Diagnostics.AddWarning("Resource Not Found", "The listed resource was not found when reading its attributes")
+			return
+		}
+	}
+
+	if err := l.setIdentity(ctx, awsClient, d); err != nil {
+		result.Diagnostics.AddError("Setting Identity", err.Error())
+		return
+	}
+
+	tfTypeIdentity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Converting Identity", err.Error())
+		return
+	}
+	result.Identity.Raw = *tfTypeIdentity
+
+	if includeResource {
+		tfTypeResource, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Converting Resource", err.Error())
+			return
+		}
+		result.Resource.Raw = *tfTypeResource
+	}
+}
+
+func (l *ListResourceWithSDKv2Resource) setIdentity(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range l.identitySpec.Attributes {
+		switch attr.Name {
+		case names.AttrAccountID:
+			if err := identity.Set(attr.Name, awsClient.AccountID(ctx)); err != nil {
+				return err
+			}
+
+		case names.AttrRegion:
+			if err := identity.Set(attr.Name, awsClient.Region(ctx)); err != nil {
+				return err
+			}
+
+		case names.AttrID:
+			if err := identity.Set(attr.Name, d.Id()); err != nil {
+				return err
+			}
+
+		default:
+			if err := identity.Set(attr.Name, d.Get(attr.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func fromSDKDiagnostics(diags sdkdiag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, v := range diags {
+		switch v.Severity {
+		case sdkdiag.Error:
+			result.AddError(v.Summary, v.Detail)
+		case sdkdiag.Warning:
+			result.AddWarning(v.Summary, v.Detail)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// policyVersion is the current IAM policy language version
+	policyVersion = "2012-10-17"
+	// policyVersion2008 is the previous IAM policy language version, still found in older resource-based policies
+	policyVersion2008 = "2008-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges the statements of one or more IAM policy documents into a single, canonical policy document. " +
+			"Semantically equivalent statements are included only once.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents, in JSON format, to merge",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// policyDocument is the subset of an IAM policy document that is merged.
+// Statements are kept as raw JSON values so that all statement elements round-trip.
+type policyDocument struct {
+	Version   string `json:",omitempty"`
+	ID        string `json:"Id,omitempty"`
+	Statement any    `json:",omitempty"`
+}
+
+// mergePolicies merges the statements of the specified IAM policy documents.
+// Statements with the same Sid must be equivalent, as must any policy Ids.
+// The merged document uses the newest policy language version of the documents.
+func mergePolicies(policies []string) (string, error) {
+	var (
+		id, version string
+		statements  []any
+	)
+	sids := make(map[string]any)
+
+	for i, policy := range policies {
+		var doc policyDocument
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		switch doc.Version {
+		case "":
+		case policyVersion, policyVersion2008:
+			version = max(version, doc.Version)
+		default:
+			return "", fmt.Errorf("policy %d: unsupported Version %q", i, doc.Version)
+		}
+
+		if doc.ID != "" {
+			if id != "" && id != doc.ID {
+				return "", fmt.Errorf("policy %d: Id (%s) conflicts with Id (%s)", i, doc.ID, id)
+			}
+			id = doc.ID
+		}
+
+		var docStatements []any
+		switch v := doc.Statement.(type) {
+		case nil:
+		case []any:
+			docStatements = v
+		case map[string]any:
+			docStatements = []any{v}
+		default:
+			return "", fmt.Errorf("policy %d: Statement must be an object or an array", i)
+		}
+
+	statements:
+		for _, statement := range docStatements {
+			m, ok := statement.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("policy %d: Statement elements must be objects", i)
+			}
+
+			if sid, ok := m["Sid"].(string); ok && sid != "" {
+				if existing, ok := sids[sid]; ok {
+					if !statementsEquivalent(existing, statement) {
+						return "", fmt.Errorf("policy %d: duplicate Sid (%s) with non-equivalent statements", i, sid)
+					}
+					continue
+				}
+				sids[sid] = statement
+			}
+
+			for _, existing := range statements {
+				if statementsEquivalent(existing, statement) {
+					continue statements
+				}
+			}
+
+			statements = append(statements, statement)
+		}
+	}
+
+	if version == "" {
+		version = policyVersion
+	}
+
+	output, err := json.Marshal(policyDocument{
+		Version:   version,
+		ID:        id,
+		Statement: statements,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// statementsEquivalent returns whether two IAM policy statements are semantically equivalent.
+func statementsEquivalent(s1, s2 any) bool {
+	policy := func(statement any) string {
+		v, _ := json.Marshal(policyDocument{
+			Version:   policyVersion,
+			Statement: []any{statement},
+		})
+		return string(v)
+	}
+
+	return verify.PolicyStringsEquivalent(policy(s1), policy(s2))
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_known(t *testing.T) {
+	t.Parallel()
+
+	policy1 := `{"Version":"2012-10-17","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	policy2 := `{"Version":"2012-10-17","Statement":{"Sid":"Two","Effect":"Allow","Action":["ec2:DescribeInstances"],"Resource":"*"}}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"One"},{"Action":["ec2:DescribeInstances"],"Effect":"Allow","Resource":"*","Sid":"Two"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_equivalentStatements(t *testing.T) {
+	t.Parallel()
+
+	policy1 := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`
+	policy2 := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["*"]}]}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_version2008(t *testing.T) {
+	t.Parallel()
+
+	policy1 := `{"Version":"2008-10-17","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	policy2 := `{"Version":"2008-10-17","Statement":[{"Sid":"Two","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	policy3 := `{"Version":"2012-10-17","Statement":[{"Sid":"Three","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2008-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"One"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"Two"}]}`),
+				),
+			},
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"One"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"*","Sid":"Three"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_id(t *testing.T) {
+	t.Parallel()
+
+	policy1 := `{"Version":"2012-10-17","Id":"Policy1","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	policy2 := `{"Version":"2012-10-17","Statement":[{"Sid":"Two","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	policy3 := `{"Version":"2012-10-17","Id":"Policy3","Statement":[{"Sid":"Three","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Id":"Policy1","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"One"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"Two"}]}`),
+				),
+			},
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(policy1, policy3),
+				ExpectError: regexache.MustCompile(`Id[\s\n]*\(Policy3\)[\s\n]*conflicts`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	policy1 := `{"Version":"2012-10-17","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	policy2 := `{"Version":"2012-10-17","Statement":[{"Sid":"One","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*character`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(args ...string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge(%[1]s)
+}
+`, testIAMPolicyMergeFunctionArgs(args))
+}
+
+func testIAMPolicyMergeFunctionArgs(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, fmt.Sprintf("%q", arg))
+	}
+
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// ipv6SubnetPrefixLen is the prefix length of VPC IPv6 subnets
+	ipv6SubnetPrefixLen = 64
+
+	// ipv6SubnetsMaxCount is the maximum number of subnets returned, the number of /64 subnets in a /48
+	ipv6SubnetsMaxCount = 1 << 16
+)
+
+var _ function.Function = ipv6CIDRSubnetsFunction{}
+
+func NewIPv6CIDRSubnetsFunction() function.Function {
+	return &ipv6CIDRSubnetsFunction{}
+}
+
+type ipv6CIDRSubnetsFunction struct{}
+
+func (f ipv6CIDRSubnetsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_cidr_subnets"
+}
+
+func (f ipv6CIDRSubnetsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ipv6_cidr_subnets Function",
+		MarkdownDescription: "Carves consecutive /64 subnets out of an IPv6 CIDR block, such as the /56 block " +
+			"associated with a VPC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv6 CIDR block with a prefix length of at most 64",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "Number of /64 subnets to return",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f ipv6CIDRSubnetsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := ipv6Subnets(cidrBlock, count)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ipv6Subnets returns the first count /64 subnets of an IPv6 CIDR block
+func ipv6Subnets(cidrBlock string, count int64) ([]string, error) {
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return nil, fmt.Errorf("CIDR block must be IPv6")
+	}
+
+	bits := prefix.Bits()
+	if bits > ipv6SubnetPrefixLen {
+		return nil, fmt.Errorf("CIDR block prefix length must be at most %d", ipv6SubnetPrefixLen)
+	}
+
+	if count < 1 || count > ipv6SubnetsMaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", ipv6SubnetsMaxCount)
+	}
+
+	// Only the upper 64 bits of the address are significant for /64 subnets.
+	if n := ipv6SubnetPrefixLen - bits; n < 16 && uint64(count) > uint64(1)<<n {
+		return nil, fmt.Errorf("CIDR block %s contains only %d /%d subnets", prefix.Masked(), uint64(1)<<n, ipv6SubnetPrefixLen)
+	}
+
+	addr := prefix.Masked().Addr().As16()
+	hi := binary.BigEndian.Uint64(addr[:8])
+
+	result := make([]string, 0, count)
+	for i := range uint64(count) {
+		var subnet [16]byte
+		binary.BigEndian.PutUint64(subnet[:8], hi+i)
+		result = append(result, netip.PrefixFrom(netip.AddrFrom16(subnet), ipv6SubnetPrefixLen).String())
+	}
+
+	return result, nil
+}
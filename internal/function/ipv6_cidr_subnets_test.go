@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIPv6CIDRSubnetsFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIPv6CIDRSubnetsFunctionConfig("2600:1f14:abc:de00::/56", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "3"),
+					resource.TestCheckOutput("first", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckOutput("last", "2600:1f14:abc:de02::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestIPv6CIDRSubnetsFunction_tooMany(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIPv6CIDRSubnetsFunctionConfig("2600:1f14:abc:de00::/56", 257),
+				ExpectError: regexache.MustCompile(`contains[\s\n]*only[\s\n]*256`),
+			},
+		},
+	})
+}
+
+func TestIPv6CIDRSubnetsFunction_invalidIPv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIPv6CIDRSubnetsFunctionConfig("10.0.0.0/16", 1),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*IPv6`),
+			},
+		},
+	})
+}
+
+func testIPv6CIDRSubnetsFunctionConfig(cidrBlock string, count int) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::ipv6_cidr_subnets(%[1]q, %[2]d)
+}
+
+output "count" {
+  value = length(local.subnets)
+}
+
+output "first" {
+  value = local.subnets[0]
+}
+
+output "last" {
+  value = local.subnets[length(local.subnets) - 1]
+}
+`, cidrBlock, count)
+}
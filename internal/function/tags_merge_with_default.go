@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsMergeWithDefaultFunction{}
+
+func NewTagsMergeWithDefaultFunction() function.Function {
+	return &tagsMergeWithDefaultFunction{}
+}
+
+type tagsMergeWithDefaultFunction struct{}
+
+func (f tagsMergeWithDefaultFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge_with_default"
+}
+
+func (f tagsMergeWithDefaultFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_merge_with_default Function",
+		MarkdownDescription: "Merges resource tags with default tags the same way the provider computes `tags_all`. " +
+			"Resource tags take precedence over default tags and AWS-reserved (`aws:` prefixed) tags are removed.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "default_tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Default tags",
+			},
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Resource tags",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeWithDefaultFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var defaultTags, tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &defaultTags, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	result := tftags.New(ctx, defaultTags).Merge(tftags.New(ctx, tags)).IgnoreAWS().Map()
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
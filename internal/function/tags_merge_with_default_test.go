@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTagsMergeWithDefaultFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  merged = provider::aws::tags_merge_with_default(
+    {
+      Environment = "dev"
+      Owner       = "platform"
+    },
+    {
+      Owner          = "app"
+      Name           = "example"
+      "aws:reserved" = "ignored"
+    },
+  )
+}
+
+output "count" {
+  value = length(local.merged)
+}
+
+output "environment" {
+  value = local.merged["Environment"]
+}
+
+output "owner" {
+  value = local.merged["Owner"]
+}
+
+output "name" {
+  value = local.merged["Name"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "3"),
+					resource.TestCheckOutput("environment", "dev"),
+					resource.TestCheckOutput("owner", "app"),
+					resource.TestCheckOutput(names.AttrName, "example"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeWithDefaultFunction_null(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  merged = provider::aws::tags_merge_with_default(null, { Name = "example" })
+}
+
+output "count" {
+  value = length(local.merged)
+}
+
+output "name" {
+  value = local.merged["Name"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+					resource.TestCheckOutput(names.AttrName, "example"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// EC2 user data reference:
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/user-data.html
+
+	// userDataMaxLen is the maximum length of user data, in raw form,
+	// before it is base64-encoded
+	userDataMaxLen = 16 * 1024
+)
+
+var _ function.Function = userDataEncodeFunction{}
+
+func NewUserDataEncodeFunction() function.Function {
+	return &userDataEncodeFunction{}
+}
+
+type userDataEncodeFunction struct{}
+
+func (f userDataEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_data_encode"
+}
+
+func (f userDataEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "user_data_encode Function",
+		MarkdownDescription: "Base64-encodes EC2 instance user data. Already encoded user data is returned unchanged, " +
+			"so the result can be passed to any `user_data_base64`-style argument.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "user_data",
+				MarkdownDescription: "User data, either raw or already base64-encoded",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f userDataEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := encodeUserData(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// encodeUserData base64-encodes user data exactly once and checks the raw length
+func encodeUserData(s string) (string, error) {
+	encoded := inttypes.Base64EncodeOnce([]byte(s))
+
+	raw, err := inttypes.Base64Decode(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	if n := len(raw); n > userDataMaxLen {
+		return "", fmt.Errorf("user data must be at most %d bytes, got %d", userDataMaxLen, n)
+	}
+
+	return encoded, nil
+}
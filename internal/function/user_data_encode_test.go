@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestUserDataEncodeFunction_raw(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUserDataEncodeFunctionConfig("#!/bin/bash\necho hello\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IyEvYmluL2Jhc2gKZWNobyBoZWxsbwo="),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataEncodeFunction_alreadyEncoded(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUserDataEncodeFunctionConfig("IyEvYmluL2Jhc2gKZWNobyBoZWxsbwo="),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IyEvYmluL2Jhc2gKZWNobyBoZWxsbwo="),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataEncodeFunction_tooLong(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testUserDataEncodeFunctionConfig("#" + strings.Repeat("x", 16*1024)),
+				ExpectError: regexache.MustCompile(`user[\s\n]*data[\s\n]*must[\s\n]*be[\s\n]*at[\s\n]*most`),
+			},
+		},
+	})
+}
+
+func testUserDataEncodeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::user_data_encode(%[1]q)
+}
+`, arg)
+}
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/dlclark/regexp2"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/tests"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating Plugin SDK migration tests for internal/service/%s", servicePackage)
+
+	var (
+		svc   serviceRecords
+		found bool
+	)
+
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		if p := l.SplitPackageRealPackage(); p != "" {
+			if p != servicePackage {
+				continue
+			}
+
+			ep := l.ProviderPackage()
+			if p == ep {
+				svc.primary = l
+				found = true
+			} else {
+				svc.additional = append(svc.additional, l)
+			}
+		} else {
+			p := l.ProviderPackage()
+
+			if p != servicePackage {
+				continue
+			}
+
+			svc.primary = l
+			found = true
+		}
+	}
+
+	if !found {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+
+	// Look for Terraform Plugin Framework resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.migrationResources {
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		if name, err := svc.ProviderNameUpper(resource.TypeName); err != nil {
+			g.Fatalf("determining provider service name: %s", err)
+		} else {
+			resource.ResourceProviderNameUpper = name
+		}
+		resource.PackageProviderNameUpper = svc.PackageProviderNameUpper()
+		resource.ProviderPackage = servicePackage
+
+		basicConfigTmplFile := path.Join("testdata", "tmpl", fmt.Sprintf("%s_basic.gtpl", sourceName))
+		tagsConfigTmplFile := path.Join("testdata", "tmpl", fmt.Sprintf("%s_tags.gtpl", sourceName))
+		var configTmplFile string
+		for _, f := range []string{basicConfigTmplFile, tagsConfigTmplFile} {
+			if _, err := os.Stat(f); err == nil {
+				configTmplFile = f
+				break
+			} else if !errors.Is(err, os.ErrNotExist) {
+				g.Fatalf("accessing config template %q: %s", f, err)
+			}
+		}
+
+		if configTmplFile == "" {
+			g.Errorf("no config template found for %q at %q or %q", sourceName, basicConfigTmplFile, tagsConfigTmplFile)
+			continue
+		}
+
+		filename := fmt.Sprintf("%s_migration_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+
+		templates, err := template.New("migrationtests").Parse(resourceTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		b, err := os.ReadFile(configTmplFile)
+		if err != nil {
+			g.Fatalf("reading config template %q: %s", configTmplFile, err)
+		}
+
+		tfTemplates, err := template.New("migrationtests").Parse(testTfTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Terraform config template: %s", err)
+		}
+
+		tfTemplates, err = tests.AddCommonTemplates(tfTemplates)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+
+		if _, err := tfTemplates.New("body").Parse(string(b)); err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplFile, err)
+		}
+
+		if _, err := tfTemplates.New("region").Parse(""); err != nil {
+			g.Fatalf("parsing config template: %s", err)
+		}
+
+		generateTestConfig(g, path.Join("testdata", resource.Name, "migration"), tfTemplates, ConfigDatum{
+			WithRName: resource.Generator != "",
+		})
+	}
+}
+
+type serviceRecords struct {
+	primary    data.ServiceRecord
+	additional []data.ServiceRecord
+}
+
+func (sr serviceRecords) ProviderNameUpper(typeName string) (string, error) {
+	if len(sr.additional) == 0 {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	for _, svc := range sr.additional {
+		if match, err := resourceTypeNameMatchesService(typeName, svc); err != nil {
+			return "", err
+		} else if match {
+			return svc.ProviderNameUpper(), nil
+		}
+	}
+
+	if match, err := resourceTypeNameMatchesService(typeName, sr.primary); err != nil {
+		return "", err
+	} else if match {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	return "", fmt.Errorf("No match found for resource type %q", typeName)
+}
+
+func (sr serviceRecords) PackageProviderNameUpper() string {
+	return sr.primary.ProviderNameUpper()
+}
+
+func resourceTypeNameMatchesService(typeName string, sr data.ServiceRecord) (bool, error) {
+	prefixActual := sr.ResourcePrefixActual()
+	if prefixActual != "" {
+		if match, err := resourceTypeNameMatchesPrefix(typeName, prefixActual); err != nil {
+			return false, err
+		} else if match {
+			return true, nil
+		}
+	}
+
+	return resourceTypeNameMatchesPrefix(typeName, sr.ResourcePrefixCorrect())
+}
+
+func resourceTypeNameMatchesPrefix(typeName, typePrefix string) (bool, error) {
+	re, err := regexp2.Compile(typePrefix, 0)
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(typeName)
+}
+
+type ResourceDatum struct {
+	ProviderPackage           string
+	ResourceProviderNameUpper string
+	PackageProviderNameUpper  string
+	Name                      string
+	TypeName                  string
+	DestroyTakesT             bool
+	CheckDestroyNoop          bool
+	FileName                  string
+	Generator                 string
+	ProviderVersion           string
+	PreChecks                 []codeBlock
+	GoImports                 []goImport
+}
+
+type goImport struct {
+	Path  string
+	Alias string
+}
+
+type codeBlock struct {
+	Code string
+}
+
+type ConfigDatum struct {
+	WithRName bool
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	migrationResources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework resource
+// migrated from the Plugin SDK.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+	defer func() {
+		v.functionName = ""
+	}()
+
+	d := ResourceDatum{
+		FileName: v.fileName,
+	}
+	isFramework := false
+	migrationTest := false
+	generatorSeen := false
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName := m[1]; annotationName {
+			case "FrameworkResource":
+				isFramework = true
+				args := common.ParseArgs(m[3])
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s.%s", v.packageName, v.functionName))
+					continue
+				}
+				d.TypeName = args.Positional[0]
+
+				if attr, ok := args.Keyword["name"]; ok {
+					attr = strings.ReplaceAll(attr, " ", "")
+					d.Name = strings.ReplaceAll(attr, "-", "")
+				}
+
+			case "SDKResource":
+				args := common.ParseArgs(m[3])
+				if len(args.Positional) > 0 {
+					d.TypeName = args.Positional[0]
+				}
+
+			case "Testing":
+				args := common.ParseArgs(m[3])
+
+				if attr, ok := args.Keyword["migrationTest"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid migrationTest value: %q at %s.%s. Should be boolean value.", attr, v.packageName, v.functionName))
+						continue
+					} else {
+						migrationTest = b
+					}
+				}
+				if attr, ok := args.Keyword["migrationTestVersion"]; ok {
+					d.ProviderVersion = attr
+				}
+				if attr, ok := args.Keyword["destroyTakesT"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid destroyTakesT value: %q at %s.%s. Should be boolean value.", attr, v.packageName, v.functionName))
+						continue
+					} else {
+						d.DestroyTakesT = b
+					}
+				}
+				if attr, ok := args.Keyword["checkDestroyNoop"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid checkDestroyNoop value: %q at %s.%s. Should be boolean value.", attr, v.packageName, v.functionName))
+						continue
+					} else {
+						d.CheckDestroyNoop = b
+					}
+				}
+				if attr, ok := args.Keyword["generator"]; ok {
+					if attr == "false" {
+						generatorSeen = true
+					} else if funcName, importSpec, err := parseIdentifierSpec(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("%s: %s.%s: %w", attr, v.packageName, v.functionName, err))
+						continue
+					} else {
+						d.Generator = funcName
+						if importSpec != nil {
+							d.GoImports = append(d.GoImports, *importSpec)
+						}
+						generatorSeen = true
+					}
+				}
+				if attr, ok := args.Keyword["name"]; ok {
+					d.Name = strings.ReplaceAll(attr, " ", "")
+				}
+				if attr, ok := args.Keyword["preCheck"]; ok {
+					if code, importSpec, err := parseIdentifierSpec(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("%s: %s.%s: %w", attr, v.packageName, v.functionName, err))
+						continue
+					} else {
+						d.PreChecks = append(d.PreChecks, codeBlock{
+							Code: fmt.Sprintf("%s(ctx, t)", code),
+						})
+						if importSpec != nil {
+							d.GoImports = append(d.GoImports, *importSpec)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if !migrationTest {
+		return
+	}
+
+	if !isFramework {
+		v.errs = append(v.errs, fmt.Errorf("migrationTest is only supported for Plugin Framework resources: %s.%s", v.packageName, v.functionName))
+		return
+	}
+
+	if d.Name == "" {
+		v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s.%s", v.packageName, v.functionName))
+		return
+	}
+
+	if d.ProviderVersion == "" {
+		v.errs = append(v.errs, fmt.Errorf("no migrationTestVersion parameter set: %s.%s", v.packageName, v.functionName))
+		return
+	}
+
+	if !generatorSeen {
+		// VCR-friendly, so that recorded interactions can be replayed.
+		d.Generator = "acctest.RandomWithPrefix(t, acctest.ResourcePrefix)"
+	}
+
+	v.migrationResources = append(v.migrationResources, d)
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+func generateTestConfig(g *common.Generator, dirPath string, tfTemplates *template.Template, configData ConfigDatum) {
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	if err := tf.BufferTemplateSet(tfTemplates, configData); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
+
+func parseIdentifierSpec(s string) (string, *goImport, error) {
+	parts := strings.Split(s, ";")
+	switch len(parts) {
+	case 1:
+		return parts[0], nil, nil
+
+	case 2:
+		return parts[1], &goImport{
+			Path: parts[0],
+		}, nil
+
+	case 3:
+		return parts[2], &goImport{
+			Path:  parts[0],
+			Alias: parts[1],
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("invalid generator value: %q", s)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/smithymodel"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	modelsDirEnvVar = "AWS_SDK_GO_V2_MODELS_DIR"
+)
+
+var (
+	input  = flag.String("Input", "", "name of the structure shape of the create operation's input, e.g. CreateFlowRequest")
+	model  = flag.String("Model", "", fmt.Sprintf("path of the Smithy JSON AST API model; defaults to $%s/<service>.json", modelsDirEnvVar))
+	output = flag.String("Output", "", "name of the structure shape describing the resource as read, e.g. Flow")
+	prefix = flag.String("Prefix", "", "prefix of generated function names, e.g. flow; defaults to the Output shape name")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	ServicePackage string
+	Functions      []Function
+}
+
+type Function struct {
+	Name        string
+	Package     string // "validator" or "planmodifier".
+	Kind        string // e.g. "String".
+	Shape       string
+	Member      string
+	Expressions []string
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	flag.Usage = usage
+	flag.Parse()
+
+	if *input == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	filename := *model
+	if filename == "" {
+		service, err := data.LookupService(servicePackage)
+		if err != nil {
+			g.Fatalf("encountered: %s", err)
+		}
+
+		dir := os.Getenv(modelsDirEnvVar)
+		if dir == "" {
+			g.Fatalf("either -Model or %s must be specified", modelsDirEnvVar)
+		}
+		filename = filepath.Join(dir, service.GoV2Package()+".json")
+	}
+
+	m, err := smithymodel.Load(filename)
+	if err != nil {
+		g.Fatalf("loading API model (%s): %s", filename, err)
+	}
+
+	functionPrefix := *prefix
+	if functionPrefix == "" {
+		functionPrefix = *output
+	}
+	if functionPrefix == "" {
+		functionPrefix = strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(*input, "Create"), "Request"), "Input")
+	}
+	functionPrefix = names.ToLowerCamelCase(functionPrefix)
+
+	b := &builder{
+		g:     g,
+		model: m,
+		seen:  make(map[string]bool),
+	}
+
+	if err := b.validators(*input, functionPrefix); err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+	if *output != "" {
+		if err := b.planModifiers(*input, *output, functionPrefix); err != nil {
+			g.Fatalf("encountered: %s", err)
+		}
+	}
+
+	outputFilename := names.ToSnakeCase(functionPrefix) + "_constraints_gen.go"
+	if args := flag.Args(); len(args) > 0 {
+		outputFilename = args[0]
+	}
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, outputFilename)
+
+	d := g.NewGoFileDestination(outputFilename)
+
+	templateData := TemplateData{
+		ServicePackage: servicePackage,
+		Functions:      b.functions,
+	}
+
+	if err := d.BufferTemplate("schemaconstraints", tmpl, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", outputFilename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", outputFilename, err)
+	}
+}
+
+type builder struct {
+	g         *common.Generator
+	model     *smithymodel.Model
+	seen      map[string]bool // Structure shapes already visited.
+	functions []Function
+}
+
+// validators adds the validator functions for the members of a structure and, recursively, its nested structures.
+// Nested structures' functions are prefixed with the structure shape's name.
+func (b *builder) validators(shapeName, functionPrefix string) error {
+	id, err := b.model.ShapeID(shapeName)
+	if err != nil {
+		return err
+	}
+	shapeName = id[strings.Index(id, "#")+1:]
+	if b.seen[id] {
+		return nil
+	}
+	b.seen[id] = true
+
+	shape, err := b.model.Structure(id)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range shape.MemberNames() {
+		c, err := b.model.MemberConstraints(shape.Members[name])
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", shapeName, name, err)
+		}
+
+		expressions, skipped := c.Validators()
+		for _, v := range skipped {
+			b.g.Warnf("%s.%s: pattern %q is not a valid Go regular expression", shapeName, name, v)
+		}
+		if len(expressions) > 0 {
+			b.functions = append(b.functions, Function{
+				Name:        functionPrefix + name + "Validators",
+				Package:     "validator",
+				Kind:        c.Kind,
+				Shape:       shapeName,
+				Member:      name,
+				Expressions: expressions,
+			})
+		}
+
+		if c.Structure != "" {
+			nested := c.Structure[strings.Index(c.Structure, "#")+1:]
+			if err := b.validators(c.Structure, names.ToLowerCamelCase(nested)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// planModifiers adds the plan modifier functions for the computed-only members of the resource,
+// those members of the output structure that are not in the input structure.
+func (b *builder) planModifiers(inputShapeName, outputShapeName, functionPrefix string) error {
+	inputShape, err := b.model.Structure(inputShapeName)
+	if err != nil {
+		return err
+	}
+	outputShape, err := b.model.Structure(outputShapeName)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range outputShape.MemberNames() {
+		if _, ok := inputShape.Members[name]; ok {
+			continue
+		}
+
+		c, err := b.model.MemberConstraints(outputShape.Members[name])
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", outputShapeName, name, err)
+		}
+
+		if expressions := c.PlanModifiers(); len(expressions) > 0 {
+			b.functions = append(b.functions, Function{
+				Name:        functionPrefix + name + "PlanModifiers",
+				Package:     "planmodifier",
+				Kind:        c.Kind,
+				Shape:       outputShapeName,
+				Member:      name,
+				Expressions: expressions,
+			})
+		}
+	}
+
+	return nil
+}
+
+//go:embed file.gtpl
+var tmpl string
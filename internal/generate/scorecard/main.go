@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+// scorecard reports, per service package, the resources that are missing
+// resource identity, import support, sweepers, generated tests, website documentation or names data.
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	checkIdentity      = "identity"
+	checkIdentityTests = "identity_tests"
+	checkImport        = "import"
+	checkImportTests   = "import_tests"
+	checkSweeper       = "sweeper"
+	checkTagsTests     = "tags_tests"
+	checkDocs          = "docs"
+	checkNamesData     = "names_data"
+)
+
+var checks = []string{
+	checkIdentity,
+	checkIdentityTests,
+	checkImport,
+	checkImportTests,
+	checkSweeper,
+	checkTagsTests,
+	checkDocs,
+	checkNamesData,
+}
+
+var (
+	failOnMissing = flag.Bool("fail-on-missing", false, "exit with a non-zero status if any resource fails a check")
+	format        = flag.String("format", "markdown", "output format: json or markdown")
+	output        = flag.String("output", "", "output file; defaults to stdout")
+	root          = flag.String("root", ".", "root directory of the provider repository")
+	service       = flag.String("service", "", "only report on the specified service package")
+	withSDKv2     = flag.Bool("sdkv2", false, "also report on Plugin SDK V2 resources")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Scorecard is the report for all service packages.
+type Scorecard struct {
+	Services []*ServiceScorecard `json:"services"`
+}
+
+// ServiceScorecard is the report for a service package.
+type ServiceScorecard struct {
+	Package   string            `json:"package"`
+	Passed    int               `json:"passed"`
+	Total     int               `json:"total"`
+	Resources []*ResourceResult `json:"resources"`
+}
+
+// ResourceResult is the report for a resource.
+// A check that does not apply to the resource, e.g. tags tests for an untagged resource, is absent from Checks.
+type ResourceResult struct {
+	TypeName  string          `json:"type_name"`
+	Framework bool            `json:"framework"`
+	Checks    map[string]bool `json:"checks"`
+}
+
+// registration is a resource registered in a service package's service_package_gen.go.
+type registration struct {
+	factory   string
+	typeName  string
+	framework bool
+	identity  bool
+	import_   bool
+	tags      bool
+}
+
+var (
+	sweeperRegexp     = regexp.MustCompile(`(?:AddTestSweepers|Register)\(\s*"(aws_[a-z0-9_]+)"`)
+	sdkImportRegexp   = regexp.MustCompile(`\bImporter:`)
+	fwImportRegexp    = regexp.MustCompile(`\bImportState\(|framework\.WithImportBy`)
+	importTestsRegexp = regexp.MustCompile(`\bImportState:\s+true`)
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *format != "json" && *format != "markdown" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	services, err := data.ReadAllServiceData()
+	if err != nil {
+		fatalf("reading service data: %s", err)
+	}
+
+	prefixes := make(map[string]string)
+	for _, v := range services {
+		if v.Exclude() {
+			continue
+		}
+		prefix := v.ResourcePrefix()
+		if prefix == "" {
+			prefix = fmt.Sprintf("aws_%s_", v.ProviderPackage())
+		}
+		prefixes[v.ProviderPackage()] = prefix
+	}
+
+	dirs, err := filepath.Glob(filepath.Join(*root, "internal", "service", "*", "service_package_gen.go"))
+	if err != nil {
+		fatalf("listing service packages: %s", err)
+	}
+
+	var scorecard Scorecard
+	for _, filename := range dirs {
+		dir := filepath.Dir(filename)
+		pkg := filepath.Base(dir)
+		if *service != "" && pkg != *service {
+			continue
+		}
+
+		v, err := scoreService(dir, pkg, prefixes)
+		if err != nil {
+			fatalf("%s: %s", pkg, err)
+		}
+
+		if len(v.Resources) > 0 {
+			scorecard.Services = append(scorecard.Services, v)
+		}
+	}
+
+	if err := writeScorecard(&scorecard); err != nil {
+		fatalf("writing scorecard: %s", err)
+	}
+
+	if n := scorecard.failed(); *failOnMissing && n > 0 {
+		fatalf("%d resources failed one or more checks", n)
+	}
+}
+
+// failed returns the number of resources that fail at least one check.
+func (s *Scorecard) failed() int {
+	var n int
+	for _, v := range s.Services {
+		for _, r := range v.Resources {
+			for _, ok := range r.Checks {
+				if !ok {
+					n++
+					break
+				}
+			}
+		}
+	}
+
+	return n
+}
+
+func writeScorecard(scorecard *Scorecard) error {
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(scorecard)
+	default:
+		return writeMarkdown(w, scorecard)
+	}
+}
+
+func scoreService(dir, pkg string, prefixes map[string]string) (*ServiceScorecard, error) {
+	registrations, err := parseRegistrations(filepath.Join(dir, "service_package_gen.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := factorySources(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sweepers, err := sweepers(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, hasNamesData := prefixes[pkg]
+
+	result := &ServiceScorecard{
+		Package: pkg,
+	}
+
+	for _, r := range registrations {
+		if !r.framework && !*withSDKv2 {
+			continue
+		}
+
+		source := sources[r.factory]
+		base := strings.TrimSuffix(source, ".go")
+		contents := readFile(source)
+		testContents := readFile(base + "_test.go")
+
+		v := &ResourceResult{
+			TypeName:  r.typeName,
+			Framework: r.framework,
+			Checks:    make(map[string]bool),
+		}
+
+		v.Checks[checkIdentity] = r.identity
+		v.Checks[checkIdentityTests] = source != "" && fileExists(base+"_identity_gen_test.go")
+		v.Checks[checkImport] = r.import_ || (r.framework && fwImportRegexp.MatchString(contents)) || (!r.framework && sdkImportRegexp.MatchString(contents))
+		v.Checks[checkImportTests] = importTestsRegexp.MatchString(testContents)
+		v.Checks[checkSweeper] = slices.Contains(sweepers, r.typeName)
+		if r.tags {
+			v.Checks[checkTagsTests] = source != "" && fileExists(base+"_tags_gen_test.go")
+		}
+		v.Checks[checkDocs] = docsExist(r.typeName)
+		v.Checks[checkNamesData] = hasNamesData && strings.HasPrefix(r.typeName, prefix)
+
+		for _, ok := range v.Checks {
+			result.Total++
+			if ok {
+				result.Passed++
+			}
+		}
+
+		result.Resources = append(result.Resources, v)
+	}
+
+	slices.SortFunc(result.Resources, func(a, b *ResourceResult) int {
+		return cmp.Compare(a.TypeName, b.TypeName)
+	})
+
+	return result, nil
+}
+
+// parseRegistrations returns the resources registered in a service_package_gen.go file.
+func parseRegistrations(filename string) ([]registration, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var registrations []registration
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		var framework bool
+		switch fn.Name.Name {
+		case "FrameworkResources":
+			framework = true
+		case "SDKResources":
+		default:
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || lit.Type != nil {
+				return true
+			}
+
+			r := registration{
+				framework: framework,
+			}
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				switch key.Name {
+				case "Factory":
+					if v, ok := kv.Value.(*ast.Ident); ok {
+						r.factory = v.Name
+					}
+				case "TypeName":
+					if v, ok := kv.Value.(*ast.BasicLit); ok {
+						r.typeName, _ = strconv.Unquote(v.Value)
+					}
+				case "Identity":
+					r.identity = true
+				case "Import":
+					r.import_ = true
+				case "Tags":
+					r.tags = true
+				}
+			}
+
+			if r.typeName == "" {
+				return true
+			}
+			registrations = append(registrations, r)
+
+			return false
+		})
+	}
+
+	return registrations, nil
+}
+
+// factorySources returns the source file declaring each function in a package, keyed by function name.
+func factorySources(dir string) (map[string]string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]string)
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") || strings.HasSuffix(filename, "_gen.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				sources[fn.Name.Name] = filename
+			}
+		}
+	}
+
+	return sources, nil
+}
+
+// sweepers returns the type names of the resources with sweepers registered in a package.
+func sweepers(dir string) ([]string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "sweep*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var typeNames []string
+	for _, filename := range filenames {
+		for _, match := range sweeperRegexp.FindAllStringSubmatch(readFile(filename), -1) {
+			typeNames = append(typeNames, match[1])
+		}
+	}
+
+	return typeNames, nil
+}
+
+func docsExist(typeName string) bool {
+	name := strings.TrimPrefix(typeName, "aws_")
+	for _, ext := range []string{".html.markdown", ".markdown", ".md"} {
+		if fileExists(filepath.Join(*root, "website", "docs", "r", name+ext)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeMarkdown(w io.Writer, scorecard *Scorecard) error {
+	var sb strings.Builder
+
+	sb.WriteString("# Resource Scorecard\n\n")
+	sb.WriteString("| Service | Resources | Score |\n")
+	sb.WriteString("|---|---|---|\n")
+	for _, v := range scorecard.Services {
+		fmt.Fprintf(&sb, "| %s | %d | %s |\n", v.Package, len(v.Resources), percentage(v.Passed, v.Total))
+	}
+
+	for _, v := range scorecard.Services {
+		fmt.Fprintf(&sb, "\n## %s\n\n", v.Package)
+
+		sb.WriteString("| Resource |")
+		for _, check := range checks {
+			fmt.Fprintf(&sb, " %s |", check)
+		}
+		sb.WriteString("\n|---|")
+		sb.WriteString(strings.Repeat("---|", len(checks)))
+		sb.WriteString("\n")
+
+		for _, r := range v.Resources {
+			fmt.Fprintf(&sb, "| `%s` |", r.TypeName)
+			for _, check := range checks {
+				switch ok, applies := r.Checks[check]; {
+				case !applies:
+					sb.WriteString(" n/a |")
+				case ok:
+					sb.WriteString(" yes |")
+				default:
+					sb.WriteString(" **no** |")
+				}
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func percentage(passed, total int) string {
+	if total == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%d%% (%d/%d)", passed*100/total, passed, total)
+}
+
+func readFile(filename string) string {
+	if filename == "" {
+		return ""
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func fileExists(filename string) bool {
+	_, err := os.Stat(filename)
+	return err == nil
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, "scorecard: "+format+"\n", a...)
+	os.Exit(1)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRegistrations(t *testing.T) {
+	t.Parallel()
+
+	const source = `
+package example
+
+func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
+	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newThingDataSource,
+			TypeName: "aws_example_thing",
+			Name:     "Thing",
+		},
+	}
+}
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newThingResource,
+			TypeName: "aws_example_thing",
+			Name:     "Thing",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalARNIdentity(),
+			Import: inttypes.FrameworkImport{
+				WrappedImport: true,
+			},
+		},
+		{
+			Factory:  newWidgetResource,
+			TypeName: "aws_example_widget",
+			Name:     "Widget",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourceGadget,
+			TypeName: "aws_example_gadget",
+			Name:     "Gadget",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+`
+
+	filename := filepath.Join(t.TempDir(), "service_package_gen.go")
+	if err := os.WriteFile(filename, []byte(source), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := parseRegistrations(filename)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []registration{
+		{
+			factory:   "newThingResource",
+			typeName:  "aws_example_thing",
+			framework: true,
+			identity:  true,
+			import_:   true,
+			tags:      true,
+		},
+		{
+			factory:   "newWidgetResource",
+			typeName:  "aws_example_widget",
+			framework: true,
+		},
+		{
+			factory:  "resourceGadget",
+			typeName: "aws_example_gadget",
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(registration{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweeperRegexp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected []string
+	}{
+		"none": {
+			input: `func RegisterSweepers() {}`,
+		},
+		"AddTestSweepers": {
+			input:    `resource.AddTestSweepers("aws_example_thing", &resource.Sweeper{`,
+			expected: []string{"aws_example_thing"},
+		},
+		"Register": {
+			input:    `awsv2.Register("aws_example_thing", sweepThings)`,
+			expected: []string{"aws_example_thing"},
+		},
+		"multiple": {
+			input: `
+	awsv2.Register("aws_example_thing", sweepThings, "aws_example_widget")
+	awsv2.Register(
+		"aws_example_widget",
+		sweepWidgets,
+	)
+`,
+			expected: []string{"aws_example_thing", "aws_example_widget"},
+		},
+		"not a resource type": {
+			input: `awsv2.Register("example_thing", sweepThings)`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, match := range sweeperRegexp.FindAllStringSubmatch(testCase.input, -1) {
+				got = append(got, match[1])
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFWImportRegexp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected bool
+	}{
+		"none": {
+			input: `func (r *thingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {`,
+		},
+		"ImportState": {
+			input:    `func (r *thingResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {`,
+			expected: true,
+		},
+		"WithImportByID": {
+			input: `type thingResource struct {
+	framework.ResourceWithModel[thingResourceModel]
+	framework.WithImportByID
+}`,
+			expected: true,
+		},
+		"WithImportByIdentity": {
+			input:    `	framework.WithImportByIdentity`,
+			expected: true,
+		},
+		"ImportStatePassthroughID": {
+			input:    `	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)`,
+			expected: false,
+		},
+		"WithNoOpUpdate": {
+			input:    `	framework.WithNoOpUpdate[thingResourceModel]`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := fwImportRegexp.MatchString(testCase.input), testCase.expected; got != want {
+				t.Errorf("MatchString = %v, want %v", got, want)
+			}
+		})
+	}
+}
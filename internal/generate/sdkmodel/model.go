@@ -0,0 +1,784 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkmodel builds a model of a Terraform Plugin Framework resource from an AWS SDK for Go v2 API client
+// by reflecting on the client's operations and their input and output structs.
+//
+// Model fields are named so that AutoFlex (internal/framework/flex) maps them to and from the API structs,
+// using the resource name as the field name prefix.
+// The model is used by skaff to generate a resource.
+package sdkmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	pluralize "github.com/gertd/go-pluralize"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resource models a resource.
+type Resource struct {
+	Name       string // e.g. "Flow".
+	SDKPackage string // e.g. "mediaconnect".
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string // Empty if the resource cannot be updated.
+	DeleteOperation string // Empty if the resource cannot be deleted.
+	ListOperation   string // Empty if the resource cannot be listed.
+
+	CreateOutputField string // Field of the Create output containing the resource. Empty if the output itself.
+	ReadInputField    string // Field of the Read input identifying the resource.
+	ReadOutputField   string // Field of the Read output containing the resource. Empty if the output itself.
+	ReadOutputType    string // Type of the resource returned by Read, e.g. "awstypes.Flow".
+	DeleteInputField  string // Field of the Delete input identifying the resource.
+	ListOutputField   string // Field of the List output containing the resource summaries.
+	ListItemField     string // Field of a resource summary identifying the resource.
+	ListPaginated     bool   // Whether the List operation is paginated.
+
+	IdentifierAttribute string // Schema name of the attribute identifying the resource, e.g. "arn".
+	IdentifierField     string // Model field identifying the resource, e.g. "ARN".
+
+	StatusField      string   // Status field of the resource. Empty if the resource has no status.
+	StatusType       string   // Type of the status field, e.g. "awstypes.Status". Empty if not an enum.
+	PendingStatuses  []string // Go expressions for statuses while creating or updating.
+	TargetStatuses   []string // Go expressions for statuses once created or updated.
+	DeletingStatuses []string // Go expressions for statuses while deleting.
+
+	Tags bool // Whether the resource is tagged on creation.
+
+	Model        *Struct   // The resource model.
+	NestedModels []*Struct // Nested object models, ordered by name.
+	Unsupported  []string  // Paths of API fields whose types are not supported.
+	Imports      []string  // Non-standard library imports required by the schema and models.
+}
+
+// Struct models a resource or nested object model struct.
+type Struct struct {
+	Name    string   // Go type name, e.g. "resourceFlowModel".
+	SDKType string   // API type, e.g. "awstypes.Source".
+	Fields  []*Field // Ordered by attribute name.
+}
+
+// Field models a model struct field and its schema attribute or block.
+type Field struct {
+	Name      string // Go field name, e.g. "ARN".
+	Attribute string // Schema attribute name, e.g. "arn".
+
+	ModelType   string // Go type of the model field, e.g. "types.String".
+	SchemaType  string // Schema attribute or block type, e.g. "schema.StringAttribute".
+	CustomType  string // Go expression for the attribute's custom type. Empty if none.
+	ElementType string // Go expression for a collection's element type. Empty if none.
+	Block       bool   // Whether the field is a nested block.
+	MaxItems1   bool   // Whether a nested block or attribute has at most one element.
+	Nested      *Struct
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	PlanModifier    string // Plan modifier kind, e.g. "String".
+}
+
+// sdkField is an API struct field seen in one or more operations.
+type sdkField struct {
+	name     string
+	typ      reflect.Type
+	create   bool
+	update   bool
+	read     bool
+	required bool
+}
+
+var (
+	plural = pluralize.NewClient()
+
+	errStop = errors.New("stop")
+
+	// ignoredFields are API fields that are not modelled.
+	ignoredFields = []string{
+		"ClientRequestToken",
+		"ClientToken",
+		"DryRun",
+		"IdempotencyToken",
+		"MaxResults",
+		"NextToken",
+		"ResultMetadata",
+		"Tags",
+	}
+
+	createVerbs = []string{"Create", "Put", "Register", "Add", "Start"}
+	readVerbs   = []string{"Describe", "Get"}
+	updateVerbs = []string{"Update", "Modify", "Put"}
+	deleteVerbs = []string{"Delete", "Deregister", "Remove", "Stop"}
+)
+
+// NewResource returns the model of the resource created by the specified operation of the specified API client.
+// client is a configured client, e.g. mediaconnect.New(mediaconnect.Options{Region: "us-west-2"}).
+// No requests are sent; operations are called only to run their input validation.
+// If name is empty, the resource name is derived from the operation, e.g. "Flow" from "CreateFlow".
+func NewResource(client any, createOperation, name string) (*Resource, error) {
+	v := reflect.ValueOf(client)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("client must be a pointer to a struct, got %T", client)
+	}
+
+	if _, ok := v.Type().MethodByName(createOperation); !ok {
+		return nil, fmt.Errorf("operation %s not found", createOperation)
+	}
+
+	if name == "" {
+		for _, verb := range createVerbs {
+			if v, ok := strings.CutPrefix(createOperation, verb); ok && v != "" {
+				name = v
+				break
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("resource name cannot be derived from operation %s", createOperation)
+		}
+	}
+
+	b := &builder{
+		client:  v,
+		models:  make(map[reflect.Type]*Struct),
+		imports: make(map[string]bool),
+		resource: &Resource{
+			CreateOperation: createOperation,
+			Name:            name,
+			SDKPackage:      packageName(v.Type().Elem()),
+		},
+	}
+
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	return b.resource, nil
+}
+
+type builder struct {
+	client   reflect.Value
+	models   map[reflect.Type]*Struct
+	imports  map[string]bool
+	resource *Resource
+}
+
+func (b *builder) build() error {
+	r := b.resource
+
+	r.ReadOperation = b.findOperation(readVerbs, r.Name)
+	if r.ReadOperation == "" {
+		return fmt.Errorf("no Describe%[1]s or Get%[1]s operation found", r.Name)
+	}
+	r.UpdateOperation = b.findOperation(updateVerbs, r.Name)
+	if r.UpdateOperation == r.CreateOperation {
+		r.UpdateOperation = ""
+	}
+	r.DeleteOperation = b.findOperation(deleteVerbs, r.Name)
+	r.ListOperation = b.findOperation([]string{"List"}, plural.Plural(r.Name))
+
+	createInput, createOutput := b.operationTypes(r.CreateOperation)
+	readInput, readOutput := b.operationTypes(r.ReadOperation)
+
+	// The resource is either the Read output or one of its fields.
+	readType := readOutput
+	if f, ok := resourceField(readOutput, r.Name); ok {
+		r.ReadOutputField = f.Name
+		readType = derefType(f.Type)
+	}
+	r.ReadOutputType = b.typeName(readType)
+
+	if f, ok := resourceField(createOutput, r.Name); ok && derefType(f.Type) == readType {
+		r.CreateOutputField = f.Name
+	}
+
+	if required := b.requiredFields(r.ReadOperation, readInput); len(required) > 0 {
+		r.ReadInputField = required[0]
+	} else {
+		return fmt.Errorf("no required field found in %s input", r.ReadOperation)
+	}
+
+	if r.DeleteOperation != "" {
+		deleteInput, _ := b.operationTypes(r.DeleteOperation)
+		if required := b.requiredFields(r.DeleteOperation, deleteInput); len(required) > 0 {
+			r.DeleteInputField = required[0]
+		} else {
+			r.DeleteInputField = r.ReadInputField
+		}
+	}
+
+	if f, ok := createInput.FieldByName("Tags"); ok && f.Type.Kind() == reflect.Map {
+		r.Tags = true
+	}
+
+	// Collect the top-level fields.
+	fields := make(map[string]*sdkField)
+	add := func(typ reflect.Type, set func(*sdkField)) {
+		for f := range tfreflect.ExportedStructFields(typ) {
+			if slices.Contains(ignoredFields, f.Name) {
+				continue
+			}
+			v, ok := fields[f.Name]
+			if !ok {
+				v = &sdkField{name: f.Name, typ: f.Type}
+				fields[f.Name] = v
+			}
+			set(v)
+		}
+	}
+
+	add(createInput, func(f *sdkField) { f.create = true })
+	for _, path := range b.requiredFields(r.CreateOperation, createInput) {
+		if f, ok := fields[path]; ok {
+			f.required = true
+		}
+	}
+	if r.UpdateOperation != "" {
+		updateInput, _ := b.operationTypes(r.UpdateOperation)
+		add(updateInput, func(f *sdkField) { f.update = true })
+	}
+	add(readType, func(f *sdkField) { f.read = true })
+
+	// The identifying field is always in the model.
+	if f, ok := fields[r.ReadInputField]; ok {
+		f.read = true
+	} else {
+		fields[r.ReadInputField] = &sdkField{name: r.ReadInputField, typ: reflect.TypeFor[*string](), read: true}
+	}
+
+	createRequired := b.requiredFieldPaths(r.CreateOperation, createInput)
+
+	model := &Struct{
+		Name:    "resource" + r.Name + "Model",
+		SDKType: r.ReadOutputType,
+	}
+	for _, f := range fields {
+		field, ok := b.field(f.name, f.typ, stripPrefix(f.name, r.Name, fields), createRequired, nil)
+		if !ok {
+			r.Unsupported = append(r.Unsupported, f.name)
+			continue
+		}
+
+		switch {
+		case f.create || f.update:
+			field.Required = f.required
+			field.Optional = !f.required
+			field.RequiresReplace = f.create && (r.UpdateOperation == "" || !f.update)
+		default:
+			// Read-only.
+			field.Computed = true
+			if field.Block {
+				b.toComputedAttribute(field)
+			}
+		}
+
+		if f.name == r.ReadInputField {
+			r.IdentifierAttribute, r.IdentifierField = field.Attribute, field.Name
+			if !f.create {
+				// Identifiers set by the API are never configured, even if they are in the Update input.
+				field.Required, field.Optional, field.Computed, field.RequiresReplace = false, false, true, false
+			}
+		}
+
+		model.Fields = append(model.Fields, field)
+	}
+	sortFields(model.Fields)
+	r.Model = model
+
+	if r.ListOperation != "" {
+		_, listOutput := b.operationTypes(r.ListOperation)
+		for f := range tfreflect.ExportedStructFields(listOutput) {
+			switch {
+			case f.Name == "NextToken":
+				r.ListPaginated = true
+			case f.Type.Kind() == reflect.Slice && derefType(f.Type.Elem()).Kind() == reflect.Struct:
+				r.ListOutputField = f.Name
+				if _, ok := derefType(f.Type.Elem()).FieldByName(r.ReadInputField); ok {
+					r.ListItemField = r.ReadInputField
+				}
+			}
+		}
+		if r.ListOutputField == "" || r.ListItemField == "" {
+			r.ListOperation = ""
+		}
+	}
+
+	b.status(readType)
+
+	for _, v := range b.models {
+		if v != model {
+			r.NestedModels = append(r.NestedModels, v)
+		}
+	}
+	slices.SortFunc(r.NestedModels, func(a, b *Struct) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.Sort(r.Unsupported)
+
+	b.imports["github.com/hashicorp/terraform-plugin-framework/types"] = true
+	for _, f := range model.Fields {
+		b.fieldImports(f, true)
+	}
+	for path := range b.imports {
+		r.Imports = append(r.Imports, path)
+	}
+	slices.Sort(r.Imports)
+
+	return nil
+}
+
+// findOperation returns the name of the client's operation named <verb><name> for the first matching verb.
+func (b *builder) findOperation(verbs []string, name string) string {
+	for _, verb := range verbs {
+		if _, ok := b.client.Type().MethodByName(verb + name); ok {
+			return verb + name
+		}
+	}
+
+	return ""
+}
+
+// operationTypes returns an operation's input and output struct types.
+func (b *builder) operationTypes(operation string) (reflect.Type, reflect.Type) {
+	m, _ := b.client.Type().MethodByName(operation)
+
+	// func(*Client, context.Context, *Input, ...func(*Options)) (*Output, error)
+	return m.Type.In(2).Elem(), m.Type.Out(0).Elem()
+}
+
+// requiredFields returns the names of the top-level required fields of an operation's input.
+func (b *builder) requiredFields(operation string, input reflect.Type) []string {
+	var fields []string
+	for _, path := range b.requiredFieldPaths(operation, input) {
+		if !strings.Contains(path, ".") {
+			fields = append(fields, path)
+		}
+	}
+
+	return fields
+}
+
+// requiredFieldPaths returns the paths, e.g. "Source.Name", of the required fields of an operation's input.
+// The operation is called with an input with all nested structs present, and its input validation errors collected.
+// The request is never sent.
+func (b *builder) requiredFieldPaths(operation string, input reflect.Type) []string {
+	m := b.client.MethodByName(operation)
+
+	// func(*Options)
+	optFnType := m.Type().In(2).Elem()
+	optFn := reflect.MakeFunc(optFnType, func(args []reflect.Value) []reflect.Value {
+		apiOptions := args[0].Elem().FieldByName("APIOptions")
+		if apiOptions.IsValid() {
+			stop := func(stack *middleware.Stack) error {
+				return stack.Serialize.Add(middleware.SerializeMiddlewareFunc("sdkmodelStop",
+					func(context.Context, middleware.SerializeInput, middleware.SerializeHandler) (middleware.SerializeOutput, middleware.Metadata, error) {
+						return middleware.SerializeOutput{}, middleware.Metadata{}, errStop
+					}), middleware.Before)
+			}
+			apiOptions.Set(reflect.Append(apiOptions, reflect.ValueOf(stop)))
+		}
+		return nil
+	})
+
+	in := reflect.New(input)
+	populate(in.Elem(), nil)
+
+	out := m.Call([]reflect.Value{reflect.ValueOf(context.Background()), in, optFn})
+	err, _ := out[1].Interface().(error)
+
+	var paramsErr smithy.InvalidParamsError
+	if !errors.As(err, &paramsErr) {
+		return nil
+	}
+
+	var paths []string
+	for _, err := range paramsErr.Errs() {
+		var paramErr smithy.InvalidParamError
+		if !errors.As(err, &paramErr) {
+			continue
+		}
+		// e.g. "CreateFlowInput.Outputs[0].Protocol".
+		_, path, _ := strings.Cut(paramErr.Field(), ".")
+		paths = append(paths, stripIndexes(path))
+	}
+
+	return paths
+}
+
+// populate sets all nil nested struct pointers, slices of structs and maps of structs in a struct value
+// so that input validation reports required nested fields.
+func populate(v reflect.Value, seen []reflect.Type) {
+	if slices.Contains(seen, v.Type()) {
+		return
+	}
+	seen = append(seen, v.Type())
+
+	for f := range tfreflect.ExportedStructFields(v.Type()) {
+		fv := v.FieldByIndex(f.Index)
+		if !fv.CanSet() {
+			continue
+		}
+
+		switch t := f.Type; {
+		case t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Struct && !isTime(t.Elem()):
+			fv.Set(reflect.New(t.Elem()))
+			populate(fv.Elem(), seen)
+		case t.Kind() == reflect.Slice && derefType(t.Elem()).Kind() == reflect.Struct:
+			e := reflect.New(derefType(t.Elem())).Elem()
+			populate(e, seen)
+			if t.Elem().Kind() == reflect.Pointer {
+				e = e.Addr()
+			}
+			fv.Set(reflect.Append(reflect.MakeSlice(t, 0, 1), e))
+		}
+	}
+}
+
+// field returns the model field for an API struct field.
+func (b *builder) field(sdkName string, typ reflect.Type, name string, required []string, seen []reflect.Type) (*Field, bool) {
+	field := &Field{
+		Attribute: names.ToSnakeCase(name),
+		Name:      goName(name),
+	}
+
+	switch t := derefType(typ); {
+	case isTime(t):
+		field.ModelType, field.SchemaType, field.CustomType, field.PlanModifier = "timetypes.RFC3339", "schema.StringAttribute", "timetypes.RFC3339Type{}", "String"
+	case t.Kind() == reflect.String && isEnum(t):
+		field.ModelType = fmt.Sprintf("fwtypes.StringEnum[%s]", b.typeName(t))
+		field.SchemaType, field.CustomType, field.PlanModifier = "schema.StringAttribute", fmt.Sprintf("fwtypes.StringEnumType[%s]()", b.typeName(t)), "String"
+	case t.Kind() == reflect.String:
+		field.ModelType, field.SchemaType, field.PlanModifier = "types.String", "schema.StringAttribute", "String"
+	case t.Kind() == reflect.Bool:
+		field.ModelType, field.SchemaType, field.PlanModifier = "types.Bool", "schema.BoolAttribute", "Bool"
+	case t.Kind() == reflect.Int32:
+		field.ModelType, field.SchemaType, field.PlanModifier = "types.Int32", "schema.Int32Attribute", "Int32"
+	case t.Kind() == reflect.Int64:
+		field.ModelType, field.SchemaType, field.PlanModifier = "types.Int64", "schema.Int64Attribute", "Int64"
+	case t.Kind() == reflect.Float32:
+		field.ModelType, field.SchemaType, field.PlanModifier = "types.Float32", "schema.Float32Attribute", "Float32"
+	case t.Kind() == reflect.Float64:
+		field.ModelType, field.SchemaType, field.PlanModifier = "types.Float64", "schema.Float64Attribute", "Float64"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String && isEnum(t.Elem()):
+		field.ModelType = fmt.Sprintf("fwtypes.ListOfStringEnum[%s]", b.typeName(t.Elem()))
+		field.SchemaType, field.CustomType, field.ElementType, field.PlanModifier = "schema.ListAttribute", fmt.Sprintf("fwtypes.ListOfStringEnumType[%s]()", b.typeName(t.Elem())), "fwtypes.StringEnumType["+b.typeName(t.Elem())+"]()", "List"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		field.ModelType, field.SchemaType, field.CustomType, field.ElementType, field.PlanModifier = "fwtypes.ListOfString", "schema.ListAttribute", "fwtypes.ListOfStringType", "types.StringType", "List"
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Elem().Kind() == reflect.String:
+		field.ModelType, field.SchemaType, field.CustomType, field.ElementType, field.PlanModifier = "fwtypes.MapOfString", "schema.MapAttribute", "fwtypes.MapOfStringType", "types.StringType", "Map"
+	case t.Kind() == reflect.Struct || (t.Kind() == reflect.Slice && derefType(t.Elem()).Kind() == reflect.Struct):
+		elem, maxItems1 := t, true
+		if t.Kind() == reflect.Slice {
+			elem, maxItems1 = derefType(t.Elem()), false
+		}
+		if slices.Contains(seen, elem) {
+			// Recursive type.
+			return nil, false
+		}
+
+		nested := b.nestedModel(sdkName, elem, required, append(seen, elem))
+		field.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", nested.Name)
+		field.SchemaType, field.CustomType, field.PlanModifier = "schema.ListNestedBlock", fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", nested.Name), "List"
+		field.Block, field.MaxItems1, field.Nested = true, maxItems1, nested
+	default:
+		// e.g. unions, documents, blobs and numeric lists.
+		return nil, false
+	}
+
+	return field, true
+}
+
+// nestedModel returns the model for a nested API struct.
+func (b *builder) nestedModel(fieldName string, typ reflect.Type, required []string, seen []reflect.Type) *Struct {
+	if v, ok := b.models[typ]; ok {
+		return v
+	}
+
+	name := names.ToLowerCamelCase(plural.Singular(fieldName)) + "Model"
+	for _, v := range b.models {
+		if v.Name == name {
+			name = names.ToLowerCamelCase(typ.Name()) + "Model"
+			break
+		}
+	}
+
+	model := &Struct{
+		Name:    name,
+		SDKType: b.typeName(typ),
+	}
+	b.models[typ] = model
+
+	// Required paths relative to this struct.
+	var nestedRequired []string
+	for _, v := range required {
+		if v, ok := strings.CutPrefix(v, fieldName+"."); ok {
+			nestedRequired = append(nestedRequired, v)
+		}
+	}
+
+	for f := range tfreflect.ExportedStructFields(typ) {
+		field, ok := b.field(f.Name, f.Type, f.Name, nestedRequired, seen)
+		if !ok {
+			b.resource.Unsupported = append(b.resource.Unsupported, fieldName+"."+f.Name)
+			continue
+		}
+
+		field.Required = slices.Contains(nestedRequired, f.Name)
+		field.Optional = !field.Required
+		model.Fields = append(model.Fields, field)
+	}
+	sortFields(model.Fields)
+
+	return model
+}
+
+// toComputedAttribute converts a nested block to a computed attribute; blocks cannot be computed.
+func (b *builder) toComputedAttribute(field *Field) {
+	field.Block, field.SchemaType, field.ElementType = false, "schema.ListAttribute", fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", field.Nested.Name)
+
+	var setComputed func(*Struct)
+	setComputed = func(s *Struct) {
+		for _, f := range s.Fields {
+			f.Required, f.Optional, f.Computed = false, false, true
+			if f.Nested != nil {
+				f.Block, f.SchemaType, f.ElementType = false, "schema.ListAttribute", fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", f.Nested.Name)
+				setComputed(f.Nested)
+			}
+		}
+	}
+	setComputed(field.Nested)
+}
+
+// status sets the resource's status field and the statuses used by waiters.
+func (b *builder) status(typ reflect.Type) {
+	r := b.resource
+
+	for _, name := range []string{"Status", r.Name + "Status", "State", r.Name + "State"} {
+		f, ok := typ.FieldByName(name)
+		if !ok || derefType(f.Type).Kind() != reflect.String {
+			continue
+		}
+
+		r.StatusField = name
+		t := derefType(f.Type)
+		if !isEnum(t) {
+			return
+		}
+
+		r.StatusType = b.typeName(t)
+		typeName := r.StatusType
+		for _, v := range enumValues(t) {
+			constant := typeName + enumConstantName(v)
+			switch upper := strings.ToUpper(v); {
+			case strings.Contains(upper, "DELET"):
+				r.DeletingStatuses = append(r.DeletingStatuses, constant)
+			case strings.Contains(upper, "FAIL") || strings.Contains(upper, "ERROR"):
+			case strings.Contains(upper, "CREATING") || strings.Contains(upper, "PENDING") || strings.Contains(upper, "UPDATING") || strings.Contains(upper, "PROGRESS") || strings.Contains(upper, "STARTING") || strings.HasSuffix(upper, "ING"):
+				r.PendingStatuses = append(r.PendingStatuses, constant)
+			default:
+				r.TargetStatuses = append(r.TargetStatuses, constant)
+			}
+		}
+
+		return
+	}
+}
+
+// fieldImports records the imports required by a field's model type and schema.
+// Fields of nested objects in attributes are not in the schema.
+func (b *builder) fieldImports(f *Field, inSchema bool) {
+	if strings.Contains(f.ModelType, "fwtypes.") || strings.Contains(f.CustomType, "fwtypes.") {
+		b.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/types"] = true
+	}
+	if strings.Contains(f.ModelType, "timetypes.") {
+		b.imports["github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"] = true
+	}
+	if strings.Contains(f.ModelType, "awstypes.") || strings.Contains(f.CustomType, "awstypes.") {
+		b.imports[fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s/types", b.resource.SDKPackage)] = true
+	}
+
+	if inSchema {
+		if f.Block && (f.MaxItems1 || f.Required) {
+			b.imports["github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"] = true
+			b.imports["github.com/hashicorp/terraform-plugin-framework/schema/validator"] = true
+		}
+		if f.RequiresReplace || (f.Computed && !f.Optional) {
+			b.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"] = true
+			b.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/"+strings.ToLower(f.PlanModifier)+"planmodifier"] = true
+		}
+	}
+
+	if f.Nested != nil {
+		for _, v := range f.Nested.Fields {
+			b.fieldImports(v, inSchema && f.Block)
+		}
+	}
+}
+
+// typeName returns the Go expression for an API type in generated code.
+func (b *builder) typeName(t reflect.Type) string {
+	if strings.HasSuffix(t.PkgPath(), "/types") {
+		return "awstypes." + t.Name()
+	}
+
+	return packageName(t) + "." + t.Name()
+}
+
+func packageName(t reflect.Type) string {
+	path := t.PkgPath()
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// resourceField returns the field of an output struct containing the resource, e.g. "Flow" or "FlowDescription".
+func resourceField(output reflect.Type, name string) (reflect.StructField, bool) {
+	for _, v := range []string{name, name + "Description", name + "Detail", name + "Details", name + "Info"} {
+		if f, ok := output.FieldByName(v); ok && derefType(f.Type).Kind() == reflect.Struct && !isTime(derefType(f.Type)) {
+			return f, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// stripPrefix removes the resource name prefix from a top-level field name, e.g. "FlowArn" -> "Arn".
+// AutoFlex's field name prefix option maps the stripped name back to the API field.
+func stripPrefix(fieldName, prefix string, fields map[string]*sdkField) string {
+	v, ok := strings.CutPrefix(fieldName, prefix)
+	if !ok || v == "" || !isUpper(v[0]) {
+		return fieldName
+	}
+
+	if _, ok := fields[v]; ok {
+		return fieldName
+	}
+
+	return v
+}
+
+// stripIndexes removes slice and map indexes from a validation error field path.
+func stripIndexes(path string) string {
+	var sb strings.Builder
+	var inIndex bool
+	for _, ch := range path {
+		switch {
+		case ch == '[':
+			inIndex = true
+		case ch == ']':
+			inIndex = false
+		case !inIndex:
+			sb.WriteRune(ch)
+		}
+	}
+
+	return sb.String()
+}
+
+// initialisms maps the words of API field names to their capitalization in Go field names.
+var initialisms = map[string]string{
+	"Arn":   "ARN",
+	"Arns":  "ARNs",
+	"Dns":   "DNS",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Id":    "ID",
+	"Ids":   "IDs",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// goName returns the Go model field name for an API field name, e.g. "VpcArn" -> "VPCARN".
+// AutoFlex matches field names case-insensitively.
+func goName(name string) string {
+	var sb strings.Builder
+	for _, word := range words(name) {
+		if v, ok := initialisms[word]; ok {
+			word = v
+		}
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// words splits a PascalCase name into words.
+func words(name string) []string {
+	var words []string
+	start := 0
+	for i := 1; i < len(name); i++ {
+		if isUpper(name[i]) && (!isUpper(name[i-1]) || (i+1 < len(name) && !isUpper(name[i+1]))) {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+
+	return append(words, name[start:])
+}
+
+// enumConstantName returns the suffix of the constant for an enum value in the AWS SDK for Go v2, e.g. "IN_PROGRESS" -> "InProgress".
+func enumConstantName(value string) string {
+	var sb strings.Builder
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		if strings.ToUpper(part) == part {
+			part = strings.ToLower(part)
+		}
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return sb.String()
+}
+
+func sortFields(fields []*Field) {
+	slices.SortFunc(fields, func(a, b *Field) int {
+		return strings.Compare(a.Attribute, b.Attribute)
+	})
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+
+	return t
+}
+
+func isTime(t reflect.Type) bool {
+	return t == reflect.TypeFor[time.Time]()
+}
+
+// isEnum returns whether a type is an AWS SDK for Go v2 enum, i.e. it has a Values method.
+func isEnum(t reflect.Type) bool {
+	_, ok := t.MethodByName("Values")
+	return ok && t.Kind() == reflect.String
+}
+
+func enumValues(t reflect.Type) []string {
+	m, _ := t.MethodByName("Values")
+	out := m.Func.Call([]reflect.Value{reflect.Zero(t)})[0]
+
+	values := make([]string, out.Len())
+	for i := range out.Len() {
+		values[i] = out.Index(i).String()
+	}
+
+	return values
+}
+
+func isUpper(ch byte) bool {
+	return ch >= 'A' && ch <= 'Z'
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+)
+
+// testClient is an API client in the style of the AWS SDK for Go v2.
+type testClient struct{}
+
+type testOptions struct {
+	APIOptions []func(*middleware.Stack) error
+}
+
+type testStatus string
+
+const (
+	testStatusCreating testStatus = "CREATING"
+	testStatusActive   testStatus = "ACTIVE"
+	testStatusDeleting testStatus = "DELETING"
+	testStatusFailed   testStatus = "FAILED"
+)
+
+func (testStatus) Values() []testStatus {
+	return []testStatus{testStatusCreating, testStatusActive, testStatusDeleting, testStatusFailed}
+}
+
+type testSettings struct {
+	Mode *string
+	Size *int32
+}
+
+type testWidget struct {
+	CreatedAt   *time.Time
+	Description *string
+	Name        *string
+	Settings    *testSettings
+	Status      testStatus
+	WidgetArn   *string
+}
+
+type testWidgetSummary struct {
+	Name      *string
+	WidgetArn *string
+}
+
+type createWidgetInput struct {
+	ClientToken *string
+	Description *string
+	Name        *string
+	Settings    *testSettings
+	Tags        map[string]string
+}
+
+type createWidgetOutput struct {
+	Widget *testWidget
+}
+
+type describeWidgetInput struct {
+	WidgetArn *string
+}
+
+type describeWidgetOutput struct {
+	Widget *testWidget
+}
+
+type updateWidgetInput struct {
+	Description *string
+	Settings    *testSettings
+	WidgetArn   *string
+}
+
+type updateWidgetOutput struct {
+	Widget *testWidget
+}
+
+type deleteWidgetInput struct {
+	WidgetArn *string
+}
+
+type deleteWidgetOutput struct{}
+
+type listWidgetsInput struct {
+	MaxResults *int32
+	NextToken  *string
+}
+
+type listWidgetsOutput struct {
+	NextToken *string
+	Widgets   []testWidgetSummary
+}
+
+func (*testClient) CreateWidget(_ context.Context, input *createWidgetInput, _ ...func(*testOptions)) (*createWidgetOutput, error) {
+	invalidParams := smithy.InvalidParamsError{Context: "CreateWidgetInput"}
+	if input.Name == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Name"))
+	}
+	if input.Settings != nil {
+		nested := smithy.InvalidParamsError{Context: "Settings"}
+		if input.Settings.Mode == nil {
+			nested.Add(smithy.NewErrParamRequired("Mode"))
+		}
+		invalidParams.AddNested("Settings", nested)
+	}
+
+	return nil, invalidParams
+}
+
+func (*testClient) DescribeWidget(_ context.Context, input *describeWidgetInput, _ ...func(*testOptions)) (*describeWidgetOutput, error) {
+	return nil, widgetARNRequired("DescribeWidgetInput", input.WidgetArn)
+}
+
+func (*testClient) UpdateWidget(_ context.Context, input *updateWidgetInput, _ ...func(*testOptions)) (*updateWidgetOutput, error) {
+	return nil, widgetARNRequired("UpdateWidgetInput", input.WidgetArn)
+}
+
+func (*testClient) DeleteWidget(_ context.Context, input *deleteWidgetInput, _ ...func(*testOptions)) (*deleteWidgetOutput, error) {
+	return nil, widgetARNRequired("DeleteWidgetInput", input.WidgetArn)
+}
+
+func (*testClient) ListWidgets(context.Context, *listWidgetsInput, ...func(*testOptions)) (*listWidgetsOutput, error) {
+	return nil, errStop
+}
+
+func widgetARNRequired(context string, arn *string) error {
+	invalidParams := smithy.InvalidParamsError{Context: context}
+	if arn == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("WidgetArn"))
+	}
+
+	return invalidParams
+}
+
+func TestNewResource(t *testing.T) {
+	t.Parallel()
+
+	got, err := NewResource(&testClient{}, "CreateWidget", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settings := &Struct{
+		Name:    "settingModel",
+		SDKType: "sdkmodel.testSettings",
+		Fields: []*Field{
+			{Name: "Mode", Attribute: "mode", ModelType: "types.String", SchemaType: "schema.StringAttribute", PlanModifier: "String", Required: true},
+			{Name: "Size", Attribute: "size", ModelType: "types.Int32", SchemaType: "schema.Int32Attribute", PlanModifier: "Int32", Optional: true},
+		},
+	}
+	want := &Resource{
+		Name:                "Widget",
+		SDKPackage:          "sdkmodel",
+		CreateOperation:     "CreateWidget",
+		ReadOperation:       "DescribeWidget",
+		UpdateOperation:     "UpdateWidget",
+		DeleteOperation:     "DeleteWidget",
+		ListOperation:       "ListWidgets",
+		CreateOutputField:   "Widget",
+		ReadInputField:      "WidgetArn",
+		ReadOutputField:     "Widget",
+		ReadOutputType:      "sdkmodel.testWidget",
+		DeleteInputField:    "WidgetArn",
+		ListOutputField:     "Widgets",
+		ListItemField:       "WidgetArn",
+		ListPaginated:       true,
+		IdentifierAttribute: "arn",
+		IdentifierField:     "ARN",
+		StatusField:         "Status",
+		StatusType:          "sdkmodel.testStatus",
+		PendingStatuses:     []string{"sdkmodel.testStatusCreating"},
+		TargetStatuses:      []string{"sdkmodel.testStatusActive"},
+		DeletingStatuses:    []string{"sdkmodel.testStatusDeleting"},
+		Tags:                true,
+		Model: &Struct{
+			Name:    "resourceWidgetModel",
+			SDKType: "sdkmodel.testWidget",
+			Fields: []*Field{
+				{Name: "ARN", Attribute: "arn", ModelType: "types.String", SchemaType: "schema.StringAttribute", PlanModifier: "String", Computed: true},
+				{Name: "CreatedAt", Attribute: "created_at", ModelType: "timetypes.RFC3339", SchemaType: "schema.StringAttribute", CustomType: "timetypes.RFC3339Type{}", PlanModifier: "String", Computed: true},
+				{Name: "Description", Attribute: "description", ModelType: "types.String", SchemaType: "schema.StringAttribute", PlanModifier: "String", Optional: true},
+				{Name: "Name", Attribute: "name", ModelType: "types.String", SchemaType: "schema.StringAttribute", PlanModifier: "String", Required: true, RequiresReplace: true},
+				{Name: "Settings", Attribute: "settings", ModelType: "fwtypes.ListNestedObjectValueOf[settingModel]", SchemaType: "schema.ListNestedBlock", CustomType: "fwtypes.NewListNestedObjectTypeOf[settingModel](ctx)", PlanModifier: "List", Block: true, MaxItems1: true, Nested: settings, Optional: true},
+				{Name: "Status", Attribute: "status", ModelType: "fwtypes.StringEnum[sdkmodel.testStatus]", SchemaType: "schema.StringAttribute", CustomType: "fwtypes.StringEnumType[sdkmodel.testStatus]()", PlanModifier: "String", Computed: true},
+			},
+		},
+		NestedModels: []*Struct{settings},
+		Imports: []string{
+			"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes",
+			"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+			"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier",
+			"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+			"github.com/hashicorp/terraform-plugin-framework/schema/validator",
+			"github.com/hashicorp/terraform-plugin-framework/types",
+			"github.com/hashicorp/terraform-provider-aws/internal/framework/types",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewResourceErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		client    any
+		operation string
+		name      string
+	}{
+		"not a client": {
+			client:    testClient{},
+			operation: "CreateWidget",
+		},
+		"no operation": {
+			client:    &testClient{},
+			operation: "CreateGadget",
+		},
+		"no read operation": {
+			client:    &testClient{},
+			operation: "CreateWidget",
+			name:      "Gadget",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewResource(testCase.client, testCase.operation, testCase.name); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Arn":              "ARN",
+		"Description":      "Description",
+		"KmsKeyId":         "KMSKeyID",
+		"SecurityGroupIds": "SecurityGroupIDs",
+		"VpcArn":           "VPCARN",
+		"SourceArns":       "SourceARNs",
+		"EgressIp":         "EgressIP",
+	}
+
+	for input, want := range testCases {
+		if got := goName(input); got != want {
+			t.Errorf("goName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestStripIndexes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":                     "Name",
+		"Outputs[0].Protocol":      "Outputs.Protocol",
+		"Tags[key].Value":          "Tags.Value",
+		"Sources[1].Decryption[2]": "Sources.Decryption",
+	}
+
+	for input, want := range testCases {
+		if got := stripIndexes(input); got != want {
+			t.Errorf("stripIndexes(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestEnumConstantName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"ACTIVE":      "Active",
+		"IN_PROGRESS": "InProgress",
+		"in-service":  "InService",
+		"Available":   "Available",
+		"ml.m5.large": "MlM5Large",
+	}
+
+	for input, want := range testCases {
+		if got := enumConstantName(input); got != want {
+			t.Errorf("enumConstantName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
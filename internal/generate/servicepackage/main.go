@@ -62,15 +62,37 @@ func main() {
 		v := &visitor{
 			g: g,
 
-			ephemeralResources:   make(map[string]ResourceDatum, 0),
-			frameworkDataSources: make(map[string]ResourceDatum, 0),
-			frameworkResources:   make(map[string]ResourceDatum, 0),
-			sdkDataSources:       make(map[string]ResourceDatum, 0),
-			sdkResources:         make(map[string]ResourceDatum, 0),
+			ephemeralResources:     make(map[string]ResourceDatum, 0),
+			frameworkDataSources:   make(map[string]ResourceDatum, 0),
+			frameworkListResources: make(map[string]ResourceDatum, 0),
+			frameworkResources:     make(map[string]ResourceDatum, 0),
+			sdkDataSources:         make(map[string]ResourceDatum, 0),
+			sdkListResources:       make(map[string]ResourceDatum, 0),
+			sdkResources:           make(map[string]ResourceDatum, 0),
 		}
 
 		v.processDir(".")
 
+		// List resources inherit their friendly name, Region, tagging and Identity from the corresponding resource.
+		for typeName, listResource := range v.frameworkListResources {
+			if resource, ok := v.frameworkResources[typeName]; ok {
+				resource.FactoryName = listResource.FactoryName
+				resource.IsGlobal = resource.IsGlobal || l.IsGlobal()
+				v.frameworkListResources[typeName] = resource
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("Framework List Resource (%s) has no corresponding Framework Resource", typeName))
+			}
+		}
+		for typeName, listResource := range v.sdkListResources {
+			if resource, ok := v.sdkResources[typeName]; ok {
+				resource.FactoryName = listResource.FactoryName
+				resource.IsGlobal = resource.IsGlobal || l.IsGlobal()
+				v.sdkListResources[typeName] = resource
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("SDK List Resource (%s) has no corresponding SDK Resource", typeName))
+			}
+		}
+
 		if err := errors.Join(v.errs...); err != nil {
 			g.Fatalf("%s", err.Error())
 		}
@@ -96,8 +118,10 @@ func main() {
 			ProviderNameUpper:       l.ProviderNameUpper(),
 			EphemeralResources:      v.ephemeralResources,
 			FrameworkDataSources:    v.frameworkDataSources,
+			FrameworkListResources:  v.frameworkListResources,
 			FrameworkResources:      v.frameworkResources,
 			SDKDataSources:          v.sdkDataSources,
+			SDKListResources:        v.sdkListResources,
 			SDKResources:            v.sdkResources,
 		}
 
@@ -173,6 +197,7 @@ type ResourceDatum struct {
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
+	TagsResourceGroupsTaggingAPI      bool
 	ValidateRegionOverrideInPartition bool
 	IdentityAttributes                []identityAttribute
 	ARNIdentity                       bool
@@ -230,8 +255,10 @@ type ServiceDatum struct {
 	ProviderNameUpper       string
 	EphemeralResources      map[string]ResourceDatum
 	FrameworkDataSources    map[string]ResourceDatum
+	FrameworkListResources  map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
+	SDKListResources        map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
 	GoImports               []goImport
 }
@@ -256,11 +283,13 @@ type visitor struct {
 	functionName string
 	packageName  string
 
-	ephemeralResources   map[string]ResourceDatum
-	frameworkDataSources map[string]ResourceDatum
-	frameworkResources   map[string]ResourceDatum
-	sdkDataSources       map[string]ResourceDatum
-	sdkResources         map[string]ResourceDatum
+	ephemeralResources     map[string]ResourceDatum
+	frameworkDataSources   map[string]ResourceDatum
+	frameworkListResources map[string]ResourceDatum
+	frameworkResources     map[string]ResourceDatum
+	sdkDataSources         map[string]ResourceDatum
+	sdkListResources       map[string]ResourceDatum
+	sdkResources           map[string]ResourceDatum
 }
 
 // processDir scans a single service package directory and processes contained Go sources files.
@@ -370,6 +399,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					d.TagsResourceType = attr
 				}
 
+				if attr, ok := args.Keyword["resourceGroupsTaggingAPI"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid Tags/resourceGroupsTaggingAPI value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					} else {
+						d.TagsResourceGroupsTaggingAPI = b
+					}
+				}
+
 			case "IdentityAttribute":
 				d.WrappedImport = true
 				if len(args.Positional) == 0 {
@@ -610,6 +647,30 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkResources[typeName] = d
 				}
 
+			case "FrameworkListResource", "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				listResources := v.sdkListResources
+				if annotationName == "FrameworkListResource" {
+					listResources = v.frameworkListResources
+				}
+
+				if _, ok := listResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					listResources[typeName] = d
+				}
+
 			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package smithymodel reads the constraints documented in an AWS API model, in Smithy JSON AST format,
+// and renders them as Terraform Plugin Framework schema validators and plan modifiers.
+//
+// The API models used to generate the AWS SDK for Go v2 service modules are in the SDK's repository
+// under codegen/sdk-codegen/aws-models, e.g. codegen/sdk-codegen/aws-models/mediaconnect.json.
+package smithymodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	preludeNamespace = "smithy.api#"
+
+	traitEnum    = preludeNamespace + "enum"
+	traitLength  = preludeNamespace + "length"
+	traitPattern = preludeNamespace + "pattern"
+	traitRange   = preludeNamespace + "range"
+)
+
+// Model is an API model.
+type Model struct {
+	Shapes map[string]*Shape `json:"shapes"`
+}
+
+// Shape is a shape in an API model.
+type Shape struct {
+	Type    string                     `json:"type"`
+	Members map[string]*Member         `json:"members"` // Structure and union members.
+	Member  *Member                    `json:"member"`  // List and set member.
+	Key     *Member                    `json:"key"`     // Map key.
+	Value   *Member                    `json:"value"`   // Map value.
+	Traits  map[string]json.RawMessage `json:"traits"`
+}
+
+// Member is a member of an aggregate shape.
+type Member struct {
+	Target string                     `json:"target"`
+	Traits map[string]json.RawMessage `json:"traits"`
+}
+
+// Load reads the API model in the specified file.
+func Load(filename string) (*Model, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse reads an API model.
+func Parse(r io.Reader) (*Model, error) {
+	var m Model
+
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("decoding Smithy JSON AST: %w", err)
+	}
+
+	return &m, nil
+}
+
+// ShapeID returns the absolute ID of the shape with the specified name, e.g. "com.amazonaws.mediaconnect#Flow" for "Flow".
+func (m *Model) ShapeID(name string) (string, error) {
+	if _, ok := m.Shapes[name]; ok {
+		return name, nil
+	}
+
+	var ids []string
+	for id := range m.Shapes {
+		if strings.HasSuffix(id, "#"+name) {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("shape %s not found", name)
+	case 1:
+		return ids[0], nil
+	default:
+		slices.Sort(ids)
+		return "", fmt.Errorf("shape %s is ambiguous: %s", name, strings.Join(ids, ", "))
+	}
+}
+
+// Structure returns the structure shape with the specified name.
+func (m *Model) Structure(name string) (*Shape, error) {
+	id, err := m.ShapeID(name)
+	if err != nil {
+		return nil, err
+	}
+
+	shape := m.Shapes[id]
+	if shape.Type != "structure" {
+		return nil, fmt.Errorf("shape %s is a %s, not a structure", id, shape.Type)
+	}
+
+	return shape, nil
+}
+
+// MemberNames returns the names of a structure's members, in order.
+func (s *Shape) MemberNames() []string {
+	var names []string
+	for name := range s.Members {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Constraints are the constraints on a structure member.
+type Constraints struct {
+	Kind      string // Plugin Framework attribute kind, e.g. "String" or "Int64". Empty if not supported.
+	Enum      bool   // Whether the member is an enum. Enums are validated by fwtypes.StringEnum.
+	Structure string // Target shape ID if the member is a structure, or a list or map of structures.
+
+	MinLength, MaxLength *int64
+	Min, Max             *float64
+	Pattern              string
+}
+
+// MemberConstraints returns the constraints on the specified structure member.
+// Traits applied to the member take precedence over traits applied to the target shape.
+func (m *Model) MemberConstraints(member *Member) (*Constraints, error) {
+	c := &Constraints{}
+
+	target := m.Shapes[member.Target]
+	typ := shapeType(member.Target, target)
+
+	switch typ {
+	case "string", "timestamp":
+		c.Kind = "String"
+	case "enum":
+		c.Kind, c.Enum = "String", true
+	case "boolean":
+		c.Kind = "Bool"
+	case "byte", "short", "integer", "intEnum":
+		c.Kind = "Int32"
+	case "long":
+		c.Kind = "Int64"
+	case "float":
+		c.Kind = "Float32"
+	case "double":
+		c.Kind = "Float64"
+	case "list", "set":
+		c.Kind = "List"
+		if target != nil && target.Member != nil && m.isStructure(target.Member.Target) {
+			c.Structure = target.Member.Target
+		}
+	case "map":
+		c.Kind = "Map"
+	case "structure":
+		c.Kind, c.Structure = "List", member.Target
+	}
+
+	var traits []map[string]json.RawMessage
+	if target != nil {
+		traits = append(traits, target.Traits)
+	}
+	traits = append(traits, member.Traits)
+
+	for _, traits := range traits {
+		if _, ok := traits[traitEnum]; ok {
+			c.Enum = true
+		}
+		if v, ok := traits[traitLength]; ok {
+			var length struct {
+				Min *int64 `json:"min"`
+				Max *int64 `json:"max"`
+			}
+			if err := json.Unmarshal(v, &length); err != nil {
+				return nil, fmt.Errorf("decoding length trait: %w", err)
+			}
+			c.MinLength, c.MaxLength = length.Min, length.Max
+		}
+		if v, ok := traits[traitRange]; ok {
+			var rng struct {
+				Min *float64 `json:"min"`
+				Max *float64 `json:"max"`
+			}
+			if err := json.Unmarshal(v, &rng); err != nil {
+				return nil, fmt.Errorf("decoding range trait: %w", err)
+			}
+			c.Min, c.Max = rng.Min, rng.Max
+		}
+		if v, ok := traits[traitPattern]; ok {
+			if err := json.Unmarshal(v, &c.Pattern); err != nil {
+				return nil, fmt.Errorf("decoding pattern trait: %w", err)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (m *Model) isStructure(id string) bool {
+	shape, ok := m.Shapes[id]
+	return ok && shape.Type == "structure"
+}
+
+// shapeType returns the type of a shape, resolving prelude shapes such as smithy.api#String.
+func shapeType(id string, shape *Shape) string {
+	if shape != nil {
+		return shape.Type
+	}
+
+	if v, ok := strings.CutPrefix(id, preludeNamespace); ok {
+		v = strings.TrimPrefix(v, "Primitive")
+		return strings.ToLower(v[:1]) + v[1:]
+	}
+
+	return ""
+}
+
+// Validators returns the Go expressions for the validators implementing the constraints.
+// Patterns that are not valid Go regular expressions, e.g. those using lookahead, are returned in skipped.
+func (c *Constraints) Validators() (validators []string, skipped []string) {
+	switch c.Kind {
+	case "String":
+		if c.Enum {
+			break
+		}
+		if v := bounds("stringvalidator", "Length", c.MinLength, c.MaxLength); v != "" {
+			validators = append(validators, v)
+		}
+		if c.Pattern != "" {
+			if _, err := regexp.Compile(c.Pattern); err != nil {
+				skipped = append(skipped, c.Pattern)
+				break
+			}
+			validators = append(validators, fmt.Sprintf(`stringvalidator.RegexMatches(regexache.MustCompile(%s), "")`, goString(c.Pattern)))
+		}
+
+	case "Int32", "Int64", "Float32", "Float64":
+		pkg := strings.ToLower(c.Kind) + "validator"
+		lower, upper := numberLiteral(c.Kind, c.Min), numberLiteral(c.Kind, c.Max)
+		switch {
+		case lower != "" && upper != "":
+			validators = append(validators, fmt.Sprintf("%s.Between(%s, %s)", pkg, lower, upper))
+		case lower != "":
+			validators = append(validators, fmt.Sprintf("%s.AtLeast(%s)", pkg, lower))
+		case upper != "":
+			validators = append(validators, fmt.Sprintf("%s.AtMost(%s)", pkg, upper))
+		}
+
+	case "List", "Map":
+		if c.Kind == "List" && c.Structure != "" && c.MinLength == nil && c.MaxLength == nil {
+			break
+		}
+		if v := bounds(strings.ToLower(c.Kind)+"validator", "Size", c.MinLength, c.MaxLength); v != "" {
+			validators = append(validators, v)
+		}
+	}
+
+	return validators, skipped
+}
+
+// PlanModifiers returns the Go expressions for the plan modifiers of a computed-only attribute.
+func (c *Constraints) PlanModifiers() []string {
+	if c.Kind == "" {
+		return nil
+	}
+
+	return []string{strings.ToLower(c.Kind) + "planmodifier.UseStateForUnknown()"}
+}
+
+// bounds returns a length or size validator, e.g. "stringvalidator.LengthBetween(1, 128)".
+func bounds(pkg, prefix string, lower, upper *int64) string {
+	// A minimum of 0 is not a constraint.
+	if lower != nil && *lower == 0 {
+		lower = nil
+	}
+
+	switch {
+	case lower != nil && upper != nil:
+		return fmt.Sprintf("%s.%sBetween(%d, %d)", pkg, prefix, *lower, *upper)
+	case lower != nil:
+		return fmt.Sprintf("%s.%sAtLeast(%d)", pkg, prefix, *lower)
+	case upper != nil:
+		return fmt.Sprintf("%s.%sAtMost(%d)", pkg, prefix, *upper)
+	}
+
+	return ""
+}
+
+// numberLiteral returns the Go literal for a range bound of the specified attribute kind.
+func numberLiteral(kind string, v *float64) string {
+	if v == nil {
+		return ""
+	}
+
+	switch kind {
+	case "Int32", "Int64":
+		return strconv.FormatInt(int64(*v), 10)
+	default:
+		s := strconv.FormatFloat(*v, 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+		return s
+	}
+}
+
+// goString returns a Go string literal, preferring a raw string literal.
+func goString(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+
+	return "`" + s + "`"
+}
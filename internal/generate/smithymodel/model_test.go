@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package smithymodel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testModel = `{
+  "smithy": "2.0",
+  "shapes": {
+    "com.amazonaws.widgets#CreateWidgetRequest": {
+      "type": "structure",
+      "members": {
+        "Name": {
+          "target": "com.amazonaws.widgets#WidgetName",
+          "traits": { "smithy.api#required": {} }
+        },
+        "Description": {
+          "target": "smithy.api#String",
+          "traits": { "smithy.api#length": { "max": 256 } }
+        },
+        "Size": {
+          "target": "smithy.api#Integer",
+          "traits": { "smithy.api#range": { "min": 1, "max": 10 } }
+        },
+        "Ratio": {
+          "target": "smithy.api#Double",
+          "traits": { "smithy.api#range": { "min": 0.5 } }
+        },
+        "Mode": {
+          "target": "com.amazonaws.widgets#WidgetMode"
+        },
+        "Labels": {
+          "target": "com.amazonaws.widgets#Labels"
+        },
+        "Parts": {
+          "target": "com.amazonaws.widgets#Parts"
+        },
+        "Settings": {
+          "target": "com.amazonaws.widgets#Settings"
+        },
+        "Lookahead": {
+          "target": "smithy.api#String",
+          "traits": { "smithy.api#pattern": "^(?!aws:).*$" }
+        }
+      }
+    },
+    "com.amazonaws.widgets#WidgetName": {
+      "type": "string",
+      "traits": {
+        "smithy.api#length": { "min": 1, "max": 128 },
+        "smithy.api#pattern": "^[a-zA-Z0-9-]+$"
+      }
+    },
+    "com.amazonaws.widgets#WidgetMode": {
+      "type": "enum",
+      "members": {
+        "FAST": { "target": "smithy.api#Unit", "traits": { "smithy.api#enumValue": "FAST" } }
+      }
+    },
+    "com.amazonaws.widgets#Labels": {
+      "type": "list",
+      "member": { "target": "smithy.api#String" },
+      "traits": { "smithy.api#length": { "min": 0, "max": 5 } }
+    },
+    "com.amazonaws.widgets#Parts": {
+      "type": "list",
+      "member": { "target": "com.amazonaws.widgets#Part" }
+    },
+    "com.amazonaws.widgets#Part": {
+      "type": "structure",
+      "members": {
+        "Weight": { "target": "smithy.api#Long", "traits": { "smithy.api#range": { "max": 100 } } }
+      }
+    },
+    "com.amazonaws.widgets#Settings": {
+      "type": "structure",
+      "members": {}
+    }
+  }
+}`
+
+func TestMemberConstraints(t *testing.T) {
+	t.Parallel()
+
+	m, err := Parse(strings.NewReader(testModel))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := m.Structure("CreateWidgetRequest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		wantValidators    []string
+		wantSkipped       []string
+		wantPlanModifiers []string
+		wantStructure     string
+	}{
+		"Description": {
+			wantValidators:    []string{"stringvalidator.LengthAtMost(256)"},
+			wantPlanModifiers: []string{"stringplanmodifier.UseStateForUnknown()"},
+		},
+		"Labels": {
+			wantValidators:    []string{"listvalidator.SizeAtMost(5)"},
+			wantPlanModifiers: []string{"listplanmodifier.UseStateForUnknown()"},
+		},
+		"Lookahead": {
+			wantSkipped:       []string{"^(?!aws:).*$"},
+			wantPlanModifiers: []string{"stringplanmodifier.UseStateForUnknown()"},
+		},
+		"Mode": {
+			wantPlanModifiers: []string{"stringplanmodifier.UseStateForUnknown()"},
+		},
+		"Name": {
+			wantValidators: []string{
+				"stringvalidator.LengthBetween(1, 128)",
+				"stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9-]+$`), \"\")",
+			},
+			wantPlanModifiers: []string{"stringplanmodifier.UseStateForUnknown()"},
+		},
+		"Parts": {
+			wantPlanModifiers: []string{"listplanmodifier.UseStateForUnknown()"},
+			wantStructure:     "com.amazonaws.widgets#Part",
+		},
+		"Ratio": {
+			wantValidators:    []string{"float64validator.AtLeast(0.5)"},
+			wantPlanModifiers: []string{"float64planmodifier.UseStateForUnknown()"},
+		},
+		"Settings": {
+			wantPlanModifiers: []string{"listplanmodifier.UseStateForUnknown()"},
+			wantStructure:     "com.amazonaws.widgets#Settings",
+		},
+		"Size": {
+			wantValidators:    []string{"int32validator.Between(1, 10)"},
+			wantPlanModifiers: []string{"int32planmodifier.UseStateForUnknown()"},
+		},
+	}
+
+	if diff := cmp.Diff(s.MemberNames(), []string{"Description", "Labels", "Lookahead", "Mode", "Name", "Parts", "Ratio", "Settings", "Size"}); diff != "" {
+		t.Errorf("unexpected member names diff (+wanted, -got): %s", diff)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := m.MemberConstraints(s.Members[name])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			validators, skipped := c.Validators()
+			if diff := cmp.Diff(validators, testCase.wantValidators); diff != "" {
+				t.Errorf("unexpected validators diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(skipped, testCase.wantSkipped); diff != "" {
+				t.Errorf("unexpected skipped patterns diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(c.PlanModifiers(), testCase.wantPlanModifiers); diff != "" {
+				t.Errorf("unexpected plan modifiers diff (+wanted, -got): %s", diff)
+			}
+			if got, want := c.Structure, testCase.wantStructure; got != want {
+				t.Errorf("Structure = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestShapeID(t *testing.T) {
+	t.Parallel()
+
+	m, err := Parse(strings.NewReader(testModel))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := m.ShapeID("Part"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if want := "com.amazonaws.widgets#Part"; got != want {
+		t.Errorf("ShapeID = %q, want %q", got, want)
+	}
+
+	if _, err := m.ShapeID("Gadget"); err == nil {
+		t.Error("expected error for unknown shape")
+	}
+
+	if _, err := m.Structure("WidgetName"); err == nil {
+		t.Error("expected error for non-structure shape")
+	}
+}
@@ -5,13 +5,18 @@ package framework
 
 import (
 	"context"
+	"iter"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type interceptorOptions[Request, Response any] struct {
@@ -263,6 +268,39 @@ func (s interceptorInvocations) resourceImportState() []interceptorFunc[resource
 	})
 }
 
+// A list resource interceptor is functionality invoked during the list resource's List request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+type listResourceListInterceptor interface {
+	// list is invoked for a List call.
+	list(context.Context, interceptorOptions[list.ListRequest, list.ListResultsStream]) diag.Diagnostics
+}
+
+// listResourceList returns a slice of interceptors that run on list resource List.
+func (s interceptorInvocations) listResourceList() []interceptorFunc[list.ListRequest, list.ListResultsStream] {
+	return tfslices.ApplyToAll(tfslices.Filter(s, func(e any) bool {
+		_, ok := e.(listResourceListInterceptor)
+		return ok
+	}), func(e any) interceptorFunc[list.ListRequest, list.ListResultsStream] {
+		return e.(listResourceListInterceptor).list
+	})
+}
+
+type listResourceSchemaInterceptor interface {
+	// schema is invoked for a ListResourceConfigSchema call.
+	schema(context.Context, interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) diag.Diagnostics
+}
+
+// listResourceSchema returns a slice of interceptors that run on list resource ListResourceConfigSchema.
+func (s interceptorInvocations) listResourceSchema() []interceptorFunc[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse] {
+	return tfslices.ApplyToAll(tfslices.Filter(s, func(e any) bool {
+		_, ok := e.(listResourceSchemaInterceptor)
+		return ok
+	}), func(e any) interceptorFunc[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse] {
+		return e.(listResourceSchemaInterceptor).schema
+	})
+}
+
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
 type when uint16
@@ -282,6 +320,8 @@ type interceptedRequest interface {
 		ephemeral.OpenRequest |
 		ephemeral.RenewRequest |
 		ephemeral.CloseRequest |
+		list.ListResourceSchemaRequest |
+		list.ListRequest |
 		resource.SchemaRequest |
 		resource.CreateRequest |
 		resource.ReadRequest |
@@ -299,6 +339,8 @@ type interceptedResponse interface {
 		ephemeral.OpenResponse |
 		ephemeral.RenewResponse |
 		ephemeral.CloseResponse |
+		list.ListResourceSchemaResponse |
+		list.ListResultsStream |
 		resource.SchemaResponse |
 		resource.CreateResponse |
 		resource.ReadResponse |
@@ -309,9 +351,28 @@ type interceptedResponse interface {
 }
 
 // interceptedHandler returns a handler that runs any interceptors.
-func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, *Request, *Response) diag.Diagnostics, c *conns.AWSClient) func(context.Context, *Request, *Response) diag.Diagnostics {
+func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, *Request, *Response) diag.Diagnostics, typeName string, c *conns.AWSClient) func(context.Context, *Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request *Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
+
+		if operation := tracedOperation(request); operation != "" {
+			var endSpan func(string, bool)
+			ctx, endSpan = c.StartSpan(ctx, typeName, operation)
+			defer func() {
+				// List results are streamed, so the span ends once all results have been consumed.
+				if stream, ok := any(response).(*list.ListResultsStream); ok && stream.Results != nil && !diags.HasError() {
+					stream.Results = tracedListResults(stream.Results, endSpan)
+					return
+				}
+
+				endSpan(tracedID(ctx, request, response), diags.HasError())
+			}()
+
+			var endReadCacheScope func()
+			ctx, endReadCacheScope = c.ReadCacheScope(ctx, operation)
+			defer endReadCacheScope()
+		}
+
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -364,3 +425,87 @@ func interceptedHandler[Request interceptedRequest, Response interceptedResponse
 		return diags
 	}
 }
+
+// tracedOperation returns the name of the operation traced for a request, or "" if the request is not traced.
+func tracedOperation(request any) string {
+	switch request.(type) {
+	case *datasource.ReadRequest, *resource.ReadRequest:
+		return "Read"
+	case *ephemeral.OpenRequest:
+		return "Open"
+	case *ephemeral.RenewRequest:
+		return "Renew"
+	case *ephemeral.CloseRequest:
+		return "Close"
+	case *resource.CreateRequest:
+		return "Create"
+	case *resource.UpdateRequest:
+		return "Update"
+	case *resource.DeleteRequest:
+		return "Delete"
+	case *resource.ModifyPlanRequest:
+		return "Plan"
+	case *resource.ImportStateRequest:
+		return "Import"
+	case *list.ListRequest:
+		return "List"
+	default:
+		return ""
+	}
+}
+
+// tracedListResults returns list results that end a traced List operation's span once they have all been consumed.
+func tracedListResults(results iter.Seq[list.ListResult], endSpan func(string, bool)) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		var failed bool
+		defer func() {
+			endSpan("", failed)
+		}()
+
+		for result := range results {
+			if result.Diagnostics.HasError() {
+				failed = true
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// tracedID returns the value of the "id" attribute, if any, of the resource or data source that a traced request is for.
+func tracedID(ctx context.Context, request, response any) string {
+	var state *tfsdk.State
+
+	switch v := request.(type) {
+	case *resource.DeleteRequest:
+		state = &v.State
+	case *resource.ImportStateRequest:
+		return v.ID
+	case *resource.ModifyPlanRequest:
+		state = &v.State
+	}
+
+	switch v := response.(type) {
+	case *datasource.ReadResponse:
+		state = &v.State
+	case *resource.CreateResponse:
+		state = &v.State
+	case *resource.ReadResponse:
+		state = &v.State
+	case *resource.UpdateResponse:
+		state = &v.State
+	}
+
+	if state == nil || state.Schema == nil || state.Raw.IsNull() {
+		return ""
+	}
+
+	var id string
+	if state.GetAttribute(ctx, path.Root(names.AttrID), &id).HasError() {
+		return ""
+	}
+
+	return id
+}
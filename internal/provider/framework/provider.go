@@ -10,18 +10,21 @@ import (
 	"iter"
 	"log"
 	"slices"
+	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -42,11 +45,13 @@ var (
 	_ provider.Provider                       = &frameworkProvider{}
 	_ provider.ProviderWithFunctions          = &frameworkProvider{}
 	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 type frameworkProvider struct {
 	dataSources        []func() datasource.DataSource
 	ephemeralResources []func() ephemeral.EphemeralResource
+	listResources      []func() list.ListResource
 	primary            interface{ Meta() any }
 	resources          []func() resource.Resource
 	servicePackages    iter.Seq[conns.ServicePackage]
@@ -60,6 +65,7 @@ func NewProvider(ctx context.Context, primary interface{ Meta() any }) (provider
 	provider := &frameworkProvider{
 		dataSources:        make([]func() datasource.DataSource, 0),
 		ephemeralResources: make([]func() ephemeral.EphemeralResource, 0),
+		listResources:      make([]func() list.ListResource, 0),
 		primary:            primary,
 		resources:          make([]func() resource.Resource, 0),
 		servicePackages:    primary.Meta().(*conns.AWSClient).ServicePackages(ctx),
@@ -107,6 +113,16 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_organizational_units": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of AWS Organizations organizational unit (or root) IDs. The AWS account must be contained, directly or via nested organizational units, in one of them. Requires credentials for the organization's management account or a delegated administrator account.",
+			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Regions for the provider and any per-resource Region override.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -123,6 +139,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"forbidden_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of forbidden AWS Regions for the provider and any per-resource Region override.",
+			},
 			"http_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
@@ -187,6 +208,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint.",
 			},
+			"skip_read_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip caching the results of AWS API list calls shared by the refresh of many resources. Each resource is then read with its own AWS API calls.",
+			},
 			"skip_region_validation": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Skip static validation of region name. Used by users of alternative AWS-like APIs or users w/ access to regions that are not public (yet).",
@@ -316,6 +341,26 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"emulator": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to direct all AWS API calls to a local AWS API emulator, such as LocalStack or moto server.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Optional: true,
+							Description: "Synthetic AWS account ID reported by the provider. " +
+								"Defaults to `" + conns.DefaultEmulatorAccountID + "`.",
+						},
+						"endpoint": schema.StringAttribute{
+							Required: true,
+							Description: "Base endpoint URL of the AWS API emulator, used for every service without an `endpoints` override. " +
+								"Also enables path-style S3 addressing and skips EC2 metadata, credentials, region and account ID checks.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -339,6 +384,84 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration block with client-side limits on the rate of AWS API calls to a service or to a single operation of a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of API calls allowed in a burst. Defaults to `requests_per_second` rounded up.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "API operation name, e.g. `DescribeInstances`. If not set, the limit applies to all of the service's API calls.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "Maximum sustained rate of API calls per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service name, as used in the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules enforced on resource tags across all resources during planning.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_values": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of tag keys to regular expressions that the whole tag value must match.",
+						},
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Casing rule that all tag keys must follow. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+						"max_tags": schema.MapAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Description: "Map of service names to the maximum number of tags on a resource of that service.",
+						},
+						"organizations_policy_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path to an AWS Organizations tag policy JSON file from which tag key casing and allowed values rules are imported.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tag keys that every taggable resource must have.",
+						},
+						"severity": schema.StringAttribute{
+							Optional:    true,
+							Description: "Severity of tag policy violations. Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block for exporting OpenTelemetry traces of Terraform operations and AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "OTLP/HTTP endpoint URL of a trace collector, e.g. `http://localhost:4318`.",
+						},
+						"file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path of a file that spans are appended to as JSON.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -352,6 +475,7 @@ func (p *frameworkProvider) Configure(ctx context.Context, request provider.Conf
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -378,6 +502,14 @@ func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() eph
 	return slices.Clone(p.ephemeralResources)
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// All list resources must have unique type names.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return slices.Clone(p.listResources)
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -387,7 +519,12 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIPv6CIDRSubnetsFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewTagsMergeWithDefaultFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewUserDataEncodeFunction,
 	}
 }
 
@@ -397,6 +534,11 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 
 	var errs []error
 
+	var sdkResources map[string]*sdkschema.Resource
+	if v, ok := p.primary.(*sdkschema.Provider); ok {
+		sdkResources = v.ResourcesMap
+	}
+
 	for sp := range p.servicePackages {
 		servicePackageName := sp.ServicePackageName()
 
@@ -450,6 +592,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
+						ctx = c.RegisterVCR(ctx)
 						ctx = fwflex.RegisterLogger(ctx)
 					}
 
@@ -509,6 +652,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
+							ctx = c.RegisterVCR(ctx)
 							ctx = fwflex.RegisterLogger(ctx)
 							ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
 						}
@@ -597,6 +741,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
+						ctx = c.RegisterVCR(ctx)
 						ctx = fwflex.RegisterLogger(ctx)
 					}
 
@@ -614,11 +759,111 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				return newWrappedResource(inner, opts)
 			})
 		}
+
+		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			for _, v := range v.FrameworkListResources(ctx) {
+				typeName := v.TypeName
+				inner := v.Factory()
+
+				if len(v.Identity.Attributes) == 0 {
+					errs = append(errs, fmt.Errorf("list resource type %s: no Identity", typeName))
+					continue
+				}
+
+				opts := newWrappedListResourceOptions(servicePackageName, typeName, v.Name, v.Region)
+				p.listResources = append(p.listResources, func() list.ListResource {
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
+
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			for _, v := range v.SDKListResources(ctx) {
+				typeName := v.TypeName
+				inner := v.Factory()
+
+				if len(v.Identity.Attributes) == 0 {
+					errs = append(errs, fmt.Errorf("list resource type %s: no Identity", typeName))
+					continue
+				}
+
+				// The Plugin SDK resource has been fully initialized (e.g. has interceptors and Identity) by the primary provider.
+				r, ok := sdkResources[typeName]
+				if !ok {
+					errs = append(errs, fmt.Errorf("list resource type %s: no corresponding Plugin SDK resource", typeName))
+					continue
+				}
+
+				switch inner := inner.(type) {
+				case framework.ListResourceWithSDKv2Resourcer:
+					inner.SetResourceSchema(r)
+					inner.SetIdentitySpec(v.Identity)
+
+				default:
+					errs = append(errs, fmt.Errorf("list resource type %s: cannot configure Plugin SDK resource", typeName))
+					continue
+				}
+
+				opts := newWrappedListResourceOptions(servicePackageName, typeName, v.Name, v.Region)
+				p.listResources = append(p.listResources, func() list.ListResource {
+					return newWrappedListResourceSDK(inner, opts)
+				})
+			}
+		}
 	}
 
 	return errors.Join(errs...)
 }
 
+// newWrappedListResourceOptions returns the interceptor dispatcher options for a list resource.
+func newWrappedListResourceOptions(servicePackageName, typeName, name string, region unique.Handle[inttypes.ServicePackageResourceRegion]) wrappedListResourceOptions {
+	var isRegionOverrideEnabled bool
+	if v := region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
+
+	var interceptors interceptorInvocations
+
+	if isRegionOverrideEnabled {
+		v := region.Value()
+
+		interceptors = append(interceptors, listResourceInjectRegionAttribute())
+		if v.IsValidateOverrideInPartition {
+			interceptors = append(interceptors, listResourceValidateRegion())
+		}
+	}
+
+	return wrappedListResourceOptions{
+		// bootstrapContext is run on all wrapped methods before any interceptors.
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			var overrideRegion string
+
+			if isRegionOverrideEnabled && getAttribute != nil {
+				var target types.String
+				diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+				if diags.HasError() {
+					return ctx, diags
+				}
+
+				overrideRegion = target.ValueString()
+			}
+
+			ctx = conns.NewResourceContext(ctx, servicePackageName, name, overrideRegion)
+			if c != nil {
+				ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+				ctx = c.RegisterLogger(ctx)
+				ctx = c.RegisterVCR(ctx)
+				ctx = fwflex.RegisterLogger(ctx)
+			}
+
+			return ctx, diags
+		},
+		interceptors: interceptors,
+		typeName:     typeName,
+	}
+}
+
 // validateResourceSchemas is called from `New` to validate Terraform Plugin Framework-style resource schemas.
 func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 	var errs []error
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +29,10 @@ func validateInContextRegionInPartition(ctx context.Context, c *conns.AWSClient)
 		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
 	}
 
+	if err := c.ValidateInContextRegionAllowed(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Region Not Allowed", err.Error())
+	}
+
 	return diags
 }
 
@@ -184,6 +190,56 @@ func ephemeralResourceValidateRegion() ephemeralResourceORCInterceptor {
 	return &ephemeralResourceValidateRegionInterceptor{}
 }
 
+type listResourceInjectRegionAttributeInterceptor struct{}
+
+func (r listResourceInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]listschema.Attribute)
+		}
+		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
+			// Inject a top-level "region" attribute.
+			response.Schema.Attributes[names.AttrRegion] = listschema.StringAttribute{
+				Optional:    true,
+				Description: names.TopLevelRegionAttributeDescription,
+			}
+		}
+	}
+
+	return diags
+}
+
+// listResourceInjectRegionAttribute injects a top-level "region" attribute into a list resource's schema.
+func listResourceInjectRegionAttribute() listResourceSchemaInterceptor {
+	return &listResourceInjectRegionAttributeInterceptor{}
+}
+
+type listResourceValidateRegionInterceptor struct{}
+
+func (r listResourceValidateRegionInterceptor) list(ctx context.Context, opts interceptorOptions[list.ListRequest, list.ListResultsStream]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		// As list resources have no ModifyPlan functionality we validate the per-resource Region override value before List.
+		diags.Append(validateInContextRegionInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// listResourceValidateRegion validates that the value of the top-level `region` attribute is in the configured AWS partition.
+func listResourceValidateRegion() listResourceListInterceptor {
+	return &listResourceValidateRegionInterceptor{}
+}
+
 type resourceInjectRegionAttributeInterceptor struct{}
 
 func (r resourceInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) diag.Diagnostics {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -257,6 +258,7 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 		if planTags.IsWhollyKnown() {
 			allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 			diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+			diags.Append(r.validateTagPolicy(ctx, c, allTags)...)
 		} else {
 			diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
@@ -269,6 +271,32 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 	return diags
 }
 
+// validateTagPolicy validates a resource's planned tags against any provider configured tag_policy.
+func (r tagsResourceInterceptor) validateTagPolicy(ctx context.Context, c *conns.AWSClient, tags tftags.KeyValueTags) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy := c.TagPolicyConfig(ctx)
+	if policy == nil {
+		return diags
+	}
+
+	sp, serviceName, resourceName, _, ok := interceptors.InfoFromContext(ctx, c)
+	if !ok {
+		return diags
+	}
+
+	for _, v := range policy.Validate(sp.ServicePackageName(), tags) {
+		summary := fmt.Sprintf("%s %s violates the provider tag policy", serviceName, resourceName)
+		if policy.IsWarning() {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), summary, v)
+		} else {
+			diags.AddAttributeError(path.Root(names.AttrTags), summary, v)
+		}
+	}
+
+	return diags
+}
+
 func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttypes.ServicePackageResourceTags]) interface {
 	resourceCRUDInterceptor
 	resourceModifyPlanInterceptor
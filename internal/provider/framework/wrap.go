@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -63,7 +64,7 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 		w.inner.Schema(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.dataSourceSchema(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.dataSourceSchema(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -91,7 +92,7 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		w.inner.Read(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.dataSourceRead(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.dataSourceRead(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -175,7 +176,7 @@ func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral
 		w.inner.Schema(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceSchema(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceSchema(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 
 	// Validate the ephemeral resource's model against the schema.
 	if v, ok := w.inner.(framework.EphemeralResourceValidateModel); ok {
@@ -200,7 +201,7 @@ func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.O
 		w.inner.Open(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceOpen(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceOpen(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
@@ -229,7 +230,7 @@ func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.
 			v.Renew(ctx, *request, response)
 			return response.Diagnostics
 		}
-		response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceRenew(), f, w.meta)(ctx, &request, response)...)
+		response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceRenew(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 	}
 }
 
@@ -245,7 +246,7 @@ func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.
 			v.Close(ctx, *request, response)
 			return response.Diagnostics
 		}
-		response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceClose(), f, w.meta)(ctx, &request, response)...)
+		response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.ephemeralResourceClose(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 	}
 }
 
@@ -279,6 +280,130 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 	}
 }
 
+type wrappedListResourceOptions struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	interceptors     interceptorInvocations
+	typeName         string
+}
+
+// wrappedListResource represents an interceptor dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	inner list.ListResourceWithConfigure
+	meta  *conns.AWSClient
+	opts  wrappedListResourceOptions
+}
+
+func newWrappedListResource(inner list.ListResourceWithConfigure, opts wrappedListResourceOptions) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		inner: inner,
+		opts:  opts,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method does not call down to the inner list resource.
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	f := func(ctx context.Context, request *list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) diag.Diagnostics {
+		w.inner.ListResourceConfigSchema(ctx, *request, response)
+		return response.Diagnostics
+	}
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.listResourceSchema(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// List results are streamed, so any diagnostics from the inner list resource are reported via the stream.
+	f := func(ctx context.Context, request *list.ListRequest, stream *list.ListResultsStream) diag.Diagnostics {
+		w.inner.List(ctx, *request, stream)
+		return nil
+	}
+	diags.Append(interceptedHandler(w.opts.interceptors.listResourceList(), f, w.opts.typeName, w.meta)(ctx, &request, stream)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	}
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigValidators(ctx context.Context) []list.ConfigValidator {
+	if v, ok := w.inner.(list.ListResourceWithConfigValidators); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		if diags.HasError() {
+			tflog.Warn(ctx, "wrapping ListResourceConfigValidators", map[string]any{
+				"list resource":          w.opts.typeName,
+				"bootstrapContext error": fwdiag.DiagnosticsString(diags),
+			})
+
+			return nil
+		}
+
+		return v.ListResourceConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedListResource) ValidateListResourceConfig(ctx context.Context, request list.ValidateConfigRequest, response *list.ValidateConfigResponse) {
+	if v, ok := w.inner.(list.ListResourceWithValidateConfig); ok {
+		ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateListResourceConfig(ctx, request, response)
+	}
+}
+
+// wrappedListResourceSDK represents an interceptor dispatcher for a Plugin Framework list resource
+// that lists instances of a Plugin SDK resource.
+type wrappedListResourceSDK struct {
+	*wrappedListResource
+	inner types.ListResourceForSDKTypeName
+}
+
+func newWrappedListResourceSDK(inner types.ListResourceForSDKTypeName, opts wrappedListResourceOptions) types.ListResourceForSDKTypeName {
+	return &wrappedListResourceSDK{
+		wrappedListResource: &wrappedListResource{
+			inner: inner,
+			opts:  opts,
+		},
+		inner: inner,
+	}
+}
+
+func (w *wrappedListResourceSDK) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	// This method does not run any interceptors.
+	w.inner.RawV5Schemas(ctx, request, response)
+}
+
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
@@ -317,7 +442,7 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 		w.inner.Schema(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceSchema(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceSchema(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 
 	// Validate the resource's model against the schema.
 	if v, ok := w.inner.(framework.ResourceValidateModel); ok {
@@ -342,7 +467,7 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		w.inner.Create(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceCreate(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceCreate(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -356,7 +481,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		w.inner.Read(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceRead(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceRead(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -370,7 +495,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		w.inner.Update(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceUpdate(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceUpdate(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -384,7 +509,7 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		w.inner.Delete(ctx, *request, response)
 		return response.Diagnostics
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceDelete(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceDelete(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -414,7 +539,7 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 			v.ImportState(ctx, *request, response)
 			return response.Diagnostics
 		}
-		response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceImportState(), f, w.meta)(ctx, &request, response)...)
+		response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceImportState(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 
 		return
 	}
@@ -442,7 +567,7 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 			return response.Diagnostics
 		}
 	}
-	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceModifyPlan(), f, w.meta)(ctx, &request, response)...)
+	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.resourceModifyPlan(), f, w.opts.typeName, w.meta)(ctx, &request, response)...)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
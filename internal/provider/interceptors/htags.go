@@ -7,6 +7,7 @@ import (
 	"context"
 	"unique"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +18,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -78,6 +80,13 @@ func (h HTags) ListTags(ctx context.Context, sp conns.ServicePackage, c *conns.A
 		} else {
 			err = v.ListTags(ctx, c, identifier, resourceType) // Sets tags in Context
 		}
+	} else if h.value().ResourceGroupsTaggingAPI && arn.IsARN(identifier) {
+		var tags map[string]tftags.KeyValueTags
+		tags, err = tftags.ListResourceTags(ctx, c.ResourceGroupsTaggingAPIClient(ctx), []string{identifier})
+
+		if inContext, ok := tftags.FromContext(ctx); ok && err == nil {
+			inContext.TagsOut = option.Some(tftags.New(ctx, tags[identifier]))
+		}
 	} else {
 		tflog.Warn(ctx, "No ListTags method found", map[string]any{
 			"ServicePackage": sp.ServicePackageName(),
@@ -114,6 +123,9 @@ func (h HTags) UpdateTags(ctx context.Context, sp conns.ServicePackage, c *conns
 		} else {
 			err = v.UpdateTags(ctx, c, identifier, resourceType, oldTags, newTags)
 		}
+	} else if h.value().ResourceGroupsTaggingAPI && arn.IsARN(identifier) {
+		// Concurrent updates of many resources' tags, e.g. when the provider's default tags change, are batched.
+		err = c.ResourceTagsBatcher(ctx).UpdateTags(ctx, sp.ServicePackageName(), identifier, oldTags, newTags)
 	} else {
 		tflog.Warn(ctx, "No UpdateTags method found", map[string]any{
 			"ServicePackage": sp.ServicePackageName(),
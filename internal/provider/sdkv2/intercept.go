@@ -120,6 +120,26 @@ const (
 	AllCRUDOps = Create | Read | Update | Delete // Interceptor is invoked for all CRUD calls
 )
 
+// operation returns the name of a single operation, as used in traces and by the read cache.
+func (w why) operation() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	case CustomizeDiff:
+		return "Plan"
+	case Import:
+		return "Import"
+	default:
+		return ""
+	}
+}
+
 type interceptorInvocations []interceptorInvocation
 
 func (s interceptorInvocations) why(why why) interceptorInvocations {
@@ -129,7 +149,7 @@ func (s interceptorInvocations) why(why why) interceptorInvocations {
 }
 
 // interceptedCRUDHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f F, why why) F {
+func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f F, why why, typeName string) F {
 	// We don't run CRUD interceptors if the resource has not defined a corresponding handler function.
 	if f == nil {
 		return nil
@@ -143,6 +163,18 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
+		if c, ok := meta.(*conns.AWSClient); ok {
+			var endSpan func(string, bool)
+			ctx, endSpan = c.StartSpan(ctx, typeName, why.operation())
+			defer func() {
+				endSpan(d.Id(), diags.HasError())
+			}()
+
+			var endReadCacheScope func()
+			ctx, endReadCacheScope = c.ReadCacheScope(ctx, why.operation())
+			defer endReadCacheScope()
+		}
+
 		// Before interceptors are run first to last.
 		forward := make([]crudInterceptorInvocation, 0)
 		for _, v := range interceptorInvocations.why(why) {
@@ -212,16 +244,24 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 }
 
 // interceptedCustomizeDiffHandler returns a handler that invokes the specified CustomizeDiff handler, running any interceptors.
-func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f schema.CustomizeDiffFunc, typeName string) schema.CustomizeDiffFunc {
 	// We run CustomizeDiff interceptors even if the resource has not defined a CustomizeDiff function.
-	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx, err := bootstrapContext(ctx, d.GetOk, meta)
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) (err error) {
+		ctx, err = bootstrapContext(ctx, d.GetOk, meta)
 		if err != nil {
 			return err
 		}
 
 		why := CustomizeDiff
 
+		if c, ok := meta.(*conns.AWSClient); ok {
+			var endSpan func(string, bool)
+			ctx, endSpan = c.StartSpan(ctx, typeName, why.operation())
+			defer func() {
+				endSpan(d.Id(), err != nil)
+			}()
+		}
+
 		// Before interceptors are run first to last.
 		forward := make([]customizeDiffInterceptorInvocation, 0)
 		for _, v := range interceptorInvocations.why(why) {
@@ -296,20 +336,28 @@ func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorIn
 }
 
 // interceptedImportHandler returns a handler that invokes the specified Imort handler, running any interceptors.
-func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f schema.StateContextFunc) schema.StateContextFunc {
+func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f schema.StateContextFunc, typeName string) schema.StateContextFunc {
 	// We don't run Import interceptors if the resource has not defined a corresponding handler function.
 	if f == nil {
 		return nil
 	}
 
-	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx, err := bootstrapContext(ctx, d.GetOk, meta)
+	return func(ctx context.Context, d *schema.ResourceData, meta any) (_ []*schema.ResourceData, err error) {
+		ctx, err = bootstrapContext(ctx, d.GetOk, meta)
 		if err != nil {
 			return nil, err
 		}
 
 		why := Import
 
+		if c, ok := meta.(*conns.AWSClient); ok {
+			var endSpan func(string, bool)
+			ctx, endSpan = c.StartSpan(ctx, typeName, why.operation())
+			defer func() {
+				endSpan(d.Id(), err != nil)
+			}()
+		}
+
 		// Before interceptors are run first to last.
 		forward := make([]importInterceptorInvocation, 0)
 		for _, v := range interceptorInvocations.why(why) {
@@ -97,7 +97,7 @@ func TestInterceptedHandler(t *testing.T) {
 		return ctx, nil
 	}
 
-	diags := interceptedCRUDHandler(bootstrapContext, interceptors, read, Read)(context.Background(), nil, 42)
+	diags := interceptedCRUDHandler(bootstrapContext, interceptors, read, Read, "aws_test")(context.Background(), nil, 42)
 	if got, want := len(diags), 1; got != want {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
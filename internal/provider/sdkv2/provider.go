@@ -11,6 +11,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -67,6 +69,21 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"allowed_organizational_units": {
+					Type:     schema.TypeSet,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Optional: true,
+					Description: "List of AWS Organizations organizational unit (or root) IDs. " +
+						"The AWS account must be contained, directly or via nested organizational units, in one of them. " +
+						"Requires credentials for the organization's management account or a delegated administrator account.",
+				},
+				"allowed_regions": {
+					Type:          schema.TypeSet,
+					Elem:          &schema.Schema{Type: schema.TypeString},
+					Optional:      true,
+					ConflictsWith: []string{"forbidden_regions"},
+					Description:   "List of allowed AWS Regions for the provider and any per-resource Region override.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
@@ -105,6 +122,29 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Protocol to use with EC2 metadata service endpoint." +
 						"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 				},
+				"emulator": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block with settings to direct all AWS API calls to a local AWS API emulator, such as LocalStack or moto server.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"account_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidAccountID,
+								Description: "Synthetic AWS account ID reported by the provider. " +
+									"Defaults to `" + conns.DefaultEmulatorAccountID + "`.",
+							},
+							"endpoint": {
+								Type:     schema.TypeString,
+								Required: true,
+								Description: "Base endpoint URL of the AWS API emulator, used for every service without an `endpoints` override. " +
+									"Also enables path-style S3 addressing and skips EC2 metadata, credentials, region and account ID checks.",
+							},
+						},
+					},
+				},
 				"endpoints": endpointsSchema(),
 				"forbidden_account_ids": {
 					Type:          schema.TypeSet,
@@ -112,6 +152,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"allowed_account_ids"},
 				},
+				"forbidden_regions": {
+					Type:          schema.TypeSet,
+					Elem:          &schema.Schema{Type: schema.TypeString},
+					Optional:      true,
+					ConflictsWith: []string{"allowed_regions"},
+					Description:   "List of forbidden AWS Regions for the provider and any per-resource Region override.",
+				},
 				"http_proxy": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -173,6 +220,35 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"rate_limit": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration block with client-side limits on the rate of AWS API calls to a service or to a single operation of a service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"burst": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "Maximum number of API calls allowed in a burst. Defaults to `requests_per_second` rounded up.",
+							},
+							"operation": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "API operation name, e.g. `DescribeInstances`. If not set, the limit applies to all of the service's API calls.",
+							},
+							"requests_per_second": {
+								Type:        schema.TypeFloat,
+								Required:    true,
+								Description: "Maximum sustained rate of API calls per second.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Service name, as used in the `endpoints` block.",
+							},
+						},
+					},
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -231,6 +307,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Skip the AWS Metadata API check. " +
 						"Used for AWS API implementations that do not have a metadata api endpoint.",
 				},
+				"skip_read_cache": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Skip caching the results of AWS API list calls shared by the refresh of many resources. " +
+						"Each resource is then read with its own AWS API calls.",
+				},
 				"skip_region_validation": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -249,6 +331,51 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS STS operations will take place. Examples\n" +
 						"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 				},
+				"tag_policy": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block with rules enforced on resource tags across all resources during planning.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_values": {
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Map of tag keys to regular expressions that the whole tag value must match.",
+							},
+							"key_case": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[tftags.PolicyKeyCase](),
+								Description:      "Casing rule that all tag keys must follow. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+							},
+							"max_tags": {
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeInt},
+								Description: "Map of service names to the maximum number of tags on a resource of that service.",
+							},
+							"organizations_policy_file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Path to an AWS Organizations tag policy JSON file from which tag key casing and allowed values rules are imported.",
+							},
+							"required_keys": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Tag keys that every taggable resource must have.",
+							},
+							"severity": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[tftags.PolicySeverity](),
+								Description:      "Severity of tag policy violations. Valid values are `error` and `warning`. Defaults to `error`.",
+							},
+						},
+					},
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -260,6 +387,26 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:    true,
 					Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 				},
+				"tracing": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block for exporting OpenTelemetry traces of Terraform operations and AWS API calls.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"endpoint": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "OTLP/HTTP endpoint URL of a trace collector, e.g. `http://localhost:4318`.",
+							},
+							"file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Path of a file that spans are appended to as JSON.",
+							},
+						},
+					},
+				},
 				"use_dualstack_endpoint": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -346,6 +493,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipReadCache:                  d.Get("skip_read_cache").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
@@ -385,6 +533,14 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_organizational_units"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationalUnits = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -432,6 +588,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("emulator"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.Emulator = expandEmulator(ctx, v.([]any)[0].(map[string]any))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -444,6 +604,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("forbidden_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.ForbiddenRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOkExists("http_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPProxy = aws.String(s)
@@ -469,6 +633,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]any)) > 0 {
+		config.RateLimits = expandRateLimits(ctx, v.([]any))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -485,6 +653,19 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		}
 	}
 
+	if v, ok := d.GetOk("tracing"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.Tracing = expandTracing(ctx, v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicy, dg := expandTagPolicy(ctx, cty.GetAttrPath("tag_policy").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicy
+	}
+
 	var c *conns.AWSClient
 	if v, ok := p.provider.Meta().(*conns.AWSClient); ok {
 		c = v
@@ -590,6 +771,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
+						ctx = c.RegisterVCR(ctx)
 					}
 
 					return ctx, nil
@@ -705,6 +887,16 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			// The tag policy applies to all resource types that support provider default tags,
+			// whether or not they implement transparent tagging.
+			if s := r.SchemaMap(); s[names.AttrTags] != nil && s[names.AttrTagsAll] != nil {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateTagPolicy(),
+				})
+			}
+
 			if len(resource.Identity.Attributes) > 0 {
 				r.Identity = newResourceIdentity(resource.Identity)
 
@@ -741,6 +933,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
+						ctx = c.RegisterVCR(ctx)
 					}
 
 					return ctx, nil
@@ -1064,6 +1257,34 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func expandEmulator(_ context.Context, tfMap map[string]any) *conns.EmulatorConfig {
+	emulator := conns.EmulatorConfig{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		emulator.AccountID = v
+	}
+
+	if v, ok := tfMap["endpoint"].(string); ok && v != "" {
+		emulator.Endpoint = v
+	}
+
+	return &emulator
+}
+
+func expandTracing(_ context.Context, tfMap map[string]any) *conns.TracingConfig {
+	tracing := conns.TracingConfig{}
+
+	if v, ok := tfMap["endpoint"].(string); ok && v != "" {
+		tracing.Endpoint = v
+	}
+
+	if v, ok := tfMap["file"].(string); ok && v != "" {
+		tracing.File = v
+	}
+
+	return &tracing
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1086,6 +1307,92 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandRateLimits(_ context.Context, tfList []any) []conns.RateLimitConfig {
+	var rateLimits []conns.RateLimitConfig
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var rateLimit conns.RateLimitConfig
+
+		if v, ok := tfMap["burst"].(int); ok {
+			rateLimit.Burst = v
+		}
+
+		if v, ok := tfMap["operation"].(string); ok {
+			rateLimit.Operation = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			rateLimit.Service = v
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+func expandTagPolicy(_ context.Context, path cty.Path, tfMap map[string]any) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := tftags.PolicyConfig{
+		Severity: tftags.PolicySeverityError,
+	}
+
+	if v, ok := tfMap["allowed_values"].(map[string]any); ok && len(v) > 0 {
+		policy.AllowedValues = make(map[string]*regexp.Regexp, len(v))
+		for k, v := range v {
+			// Tag values must match the whole regular expression.
+			re, err := regexp.Compile(`^(?:` + v.(string) + `)$`)
+			if err != nil {
+				return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("allowed_values").IndexString(k), "invalid regular expression: %s", err))
+			}
+			policy.AllowedValues[k] = re
+		}
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		policy.KeyCase = tftags.PolicyKeyCase(v)
+	}
+
+	if v, ok := tfMap["max_tags"].(map[string]any); ok && len(v) > 0 {
+		policy.MaxTags = make(map[string]int, len(v))
+		for k, v := range v {
+			policy.MaxTags[k] = v.(int)
+		}
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policy.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(policy.RequiredKeys)
+	}
+
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		policy.Severity = tftags.PolicySeverity(v)
+	}
+
+	if v, ok := tfMap["organizations_policy_file"].(string); ok && v != "" {
+		path := path.GetAttr("organizations_policy_file")
+		document, err := os.ReadFile(v)
+		if err != nil {
+			return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path, "reading AWS Organizations tag policy file (%s): %s", v, err))
+		}
+
+		if err := policy.ImportOrganizationsPolicy(document); err != nil {
+			return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path, "%s: %s", v, err))
+		}
+	}
+
+	return &policy, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
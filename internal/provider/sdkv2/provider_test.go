@@ -5,13 +5,17 @@ package sdkv2
 
 import (
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -309,6 +313,173 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandEmulator(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testcases := map[string]struct {
+		tfMap    map[string]any
+		expected *conns.EmulatorConfig
+	}{
+		"endpoint only": {
+			tfMap: map[string]any{
+				"account_id": "",
+				"endpoint":   "http://localhost:4566",
+			},
+			expected: &conns.EmulatorConfig{
+				Endpoint: "http://localhost:4566",
+			},
+		},
+		"endpoint and account ID": {
+			tfMap: map[string]any{
+				"account_id": "123456789012",
+				"endpoint":   "http://localhost:5000",
+			},
+			expected: &conns.EmulatorConfig{
+				AccountID: "123456789012",
+				Endpoint:  "http://localhost:5000",
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandEmulator(ctx, testcase.tfMap)
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("tag_policy").IndexInt(0)
+
+	policyFile := filepath.Join(t.TempDir(), "tag-policy.json")
+	if err := os.WriteFile(policyFile, []byte(`{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"}}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		tfMap         map[string]any
+		expected      *tftags.PolicyConfig
+		expectedError bool
+	}{
+		"defaults": {
+			tfMap: map[string]any{
+				"key_case": "",
+				"severity": "",
+			},
+			expected: &tftags.PolicyConfig{
+				Severity: tftags.PolicySeverityError,
+			},
+		},
+		"all rules": {
+			tfMap: map[string]any{
+				"allowed_values": map[string]any{
+					"Environment": "dev|prod",
+				},
+				"key_case": "pascal",
+				"max_tags": map[string]any{
+					"ec2": 10,
+				},
+				"organizations_policy_file": policyFile,
+				"required_keys":             schema.NewSet(schema.HashString, []any{"Owner", "CostCenter"}),
+				"severity":                  "warning",
+			},
+			expected: &tftags.PolicyConfig{
+				AllowedValues: map[string]*regexp.Regexp{
+					"Environment": regexache.MustCompile(`^(?:dev|prod)$`),
+				},
+				CanonicalKeys: map[string]string{
+					"costcenter": "CostCenter",
+				},
+				KeyCase: tftags.PolicyKeyCasePascal,
+				MaxTags: map[string]int{
+					"ec2": 10,
+				},
+				RequiredKeys: []string{"CostCenter", "Owner"},
+				Severity:     tftags.PolicySeverityWarning,
+			},
+		},
+		"invalid regular expression": {
+			tfMap: map[string]any{
+				"allowed_values": map[string]any{
+					"Environment": "dev|(prod",
+				},
+			},
+			expectedError: true,
+		},
+		"missing organizations policy file": {
+			tfMap: map[string]any{
+				"organizations_policy_file": filepath.Join(t.TempDir(), "missing.json"),
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandTagPolicy(ctx, path, testcase.tfMap)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expandTagPolicy() error %t, want %t: %v", got, want, diags)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				return x.String() == y.String()
+			})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tfList := []any{
+		map[string]any{
+			"burst":               0,
+			"operation":           "",
+			"requests_per_second": 20.0,
+			"service":             "ec2",
+		},
+		map[string]any{
+			"burst":               10,
+			"operation":           "DescribeInstances",
+			"requests_per_second": 2.5,
+			"service":             "ec2",
+		},
+		nil,
+	}
+	expected := []conns.RateLimitConfig{
+		{
+			RequestsPerSecond: 20,
+			Service:           "ec2",
+		},
+		{
+			Burst:             10,
+			Operation:         "DescribeInstances",
+			RequestsPerSecond: 2.5,
+			Service:           "ec2",
+		},
+	}
+
+	if diff := cmp.Diff(expandRateLimits(ctx, tfList), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
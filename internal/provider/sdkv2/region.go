@@ -21,7 +21,11 @@ func resourceValidateRegion() customizeDiffInterceptor {
 		case Before:
 			switch why {
 			case CustomizeDiff:
-				return c.ValidateInContextRegionInPartition(ctx)
+				if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+					return err
+				}
+
+				return c.ValidateInContextRegionAllowed(ctx)
 			}
 		}
 
@@ -42,6 +46,9 @@ func dataSourceValidateRegion() crudInterceptor {
 				if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
+				if err := c.ValidateInContextRegionAllowed(ctx); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
 			}
 		}
 
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// planWarningsKey is the context key for plan-time warnings.
+type planWarningsKey struct{}
+
+// planWarnings collects warning diagnostics raised during PlanResourceChange.
+// Plugin SDK CustomizeDiff functions can only return errors, so warnings are passed back to the provider server via the context.
+type planWarnings struct {
+	mutex       sync.Mutex
+	diagnostics []*tfprotov5.Diagnostic
+}
+
+func (w *planWarnings) add(warning *tfprotov5.Diagnostic) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.diagnostics = append(w.diagnostics, warning)
+}
+
+func (w *planWarnings) all() []*tfprotov5.Diagnostic {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.diagnostics
+}
+
+// addPlanWarning adds a warning diagnostic to the current PlanResourceChange response.
+// Returns false if the context does not support plan-time warnings.
+func addPlanWarning(ctx context.Context, warning *tfprotov5.Diagnostic) bool {
+	w, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+	if !ok {
+		return false
+	}
+
+	warning.Severity = tfprotov5.DiagnosticSeverityWarning
+	w.add(warning)
+
+	return true
+}
+
+// providerServer wraps the Plugin SDK provider server, surfacing plan-time warnings and shutting down tracing when the provider is stopped.
+type providerServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s providerServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	w := &planWarnings{}
+	ctx = context.WithValue(ctx, planWarningsKey{}, w)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if response != nil {
+		response.Diagnostics = append(response.Diagnostics, w.all()...)
+	}
+
+	return response, err
+}
+
+func (s providerServer) StopProvider(ctx context.Context, request *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	response, err := s.ProviderServer.StopProvider(ctx, request)
+
+	if v, ok := s.provider.Meta().(*conns.AWSClient); ok {
+		if err := v.Shutdown(ctx); err != nil {
+			tflog.Warn(ctx, "Shutting down tracing", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return response, err
+}
+
+// NewProviderServer returns a terraform-plugin-go protocol v5 provider server factory function for the Plugin SDK provider.
+func NewProviderServer(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return providerServer{
+			ProviderServer: p.GRPCProvider(),
+			provider:       p,
+		}
+	}
+}
@@ -6,8 +6,12 @@ package sdkv2
 import (
 	"context"
 	"fmt"
+	"strings"
 	"unique"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -297,3 +301,61 @@ func setTagsAll() customizeDiffInterceptor {
 		return nil
 	})
 }
+
+func validateTagPolicy() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		policy := c.TagPolicyConfig(ctx)
+		if policy == nil {
+			return nil
+		}
+
+		sp, serviceName, resourceName, _, ok := interceptors.InfoFromContext(ctx, c)
+		if !ok {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Tags that are not yet known are validated during a subsequent plan.
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+				violations := policy.Validate(sp.ServicePackageName(), allTags)
+				if len(violations) == 0 {
+					return nil
+				}
+
+				// CustomizeDiff cannot return warnings, so they are added to the plan response.
+				if policy.IsWarning() {
+					for _, v := range violations {
+						warning := &tfprotov5.Diagnostic{
+							Summary:   fmt.Sprintf("%s %s violates the provider tag policy", serviceName, resourceName),
+							Detail:    v,
+							Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+						}
+						if addPlanWarning(ctx, warning) {
+							continue
+						}
+
+						tflog.Warn(ctx, "Tag policy violation", map[string]any{
+							"tf_aws.service":   serviceName,
+							"tf_aws.resource":  resourceName,
+							"tf_aws.violation": v,
+						})
+					}
+					return nil
+				}
+
+				return fmt.Errorf("%s %s violates the provider tag policy: %s", serviceName, resourceName, strings.Join(violations, "; "))
+			}
+		}
+
+		return nil
+	})
+}
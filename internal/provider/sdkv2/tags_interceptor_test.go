@@ -6,15 +6,20 @@ package sdkv2
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"unique"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockService struct{}
@@ -164,3 +169,198 @@ func (d *resourceData) HasChanges(keys ...string) bool {
 func (d *resourceData) Identity() (*schema.IdentityData, error) {
 	return nil, nil
 }
+
+func TestValidateTagPolicyWarning(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	conn := &conns.AWSClient{}
+	conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
+		"Test": &mockService{},
+	})
+	conns.SetTagPolicyConfig(conn, &tftags.PolicyConfig{
+		RequiredKeys: []string{"Owner"},
+		Severity:     tftags.PolicySeverityWarning,
+	})
+
+	bootstrapContext := func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, error) {
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "")
+		if v, ok := meta.(*conns.AWSClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
+		}
+
+		return ctx, nil
+	}
+	interceptors := interceptorInvocations{
+		interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: validateTagPolicy(),
+		},
+	}
+
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Schema: map[string]*schema.Schema{
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				CustomizeDiff: interceptedCustomizeDiffHandler(bootstrapContext, interceptors, nil, "aws_test"),
+			},
+		},
+	}
+	p.SetMeta(conn)
+
+	diags := testPlanTaggedResource(ctx, t, p, "aws_test")
+
+	want := []*tfprotov5.Diagnostic{{
+		Severity:  tfprotov5.DiagnosticSeverityWarning,
+		Summary:   "<service> aws_test violates the provider tag policy",
+		Detail:    `required tag "Owner" is missing`,
+		Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+	}}
+	if diff := cmp.Diff(diags, want); diff != "" {
+		t.Errorf("unexpected diagnostics (-got +want):\n%s", diff)
+	}
+}
+
+type mockTagPolicyService struct {
+	mockService
+}
+
+func (t *mockTagPolicyService) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrTags:    tftags.TagsSchema(),
+						names.AttrTagsAll: tftags.TagsSchemaComputed(),
+					},
+				}
+			},
+			TypeName: "aws_test_tagged",
+			Name:     "Tagged",
+		},
+		{
+			Factory: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Tags used to select other resources.
+						names.AttrTags: tftags.TagsSchema(),
+					},
+				}
+			},
+			TypeName: "aws_test_filter",
+			Name:     "Filter",
+		},
+	}
+}
+
+func TestValidateTagPolicyRegistration(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	sp := &mockTagPolicyService{}
+	conn := &conns.AWSClient{}
+	conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
+		sp.ServicePackageName(): sp,
+	})
+	conns.SetTagPolicyConfig(conn, &tftags.PolicyConfig{
+		RequiredKeys: []string{"Owner"},
+		Severity:     tftags.PolicySeverityWarning,
+	})
+
+	p := &sdkProvider{
+		provider: &schema.Provider{
+			DataSourcesMap: make(map[string]*schema.Resource),
+			ResourcesMap:   make(map[string]*schema.Resource),
+		},
+		servicePackages: slices.All([]conns.ServicePackage{sp}),
+	}
+	if _, err := p.initialize(ctx); err != nil {
+		t.Fatal(err)
+	}
+	p.provider.SetMeta(conn)
+
+	testCases := map[string]struct {
+		typeName string
+		want     []*tfprotov5.Diagnostic
+	}{
+		"no transparent tagging": {
+			typeName: "aws_test_tagged",
+			want: []*tfprotov5.Diagnostic{{
+				Severity:  tfprotov5.DiagnosticSeverityWarning,
+				Summary:   "<service> Tagged violates the provider tag policy",
+				Detail:    `required tag "Owner" is missing`,
+				Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+			}},
+		},
+		"no tags_all": {
+			typeName: "aws_test_filter",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testPlanTaggedResource(ctx, t, p.provider, testCase.typeName)
+
+			if diff := cmp.Diff(diags, testCase.want); diff != "" {
+				t.Errorf("unexpected diagnostics (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// testPlanTaggedResource plans the creation of a resource of the specified type with a "Name" tag.
+func testPlanTaggedResource(ctx context.Context, t *testing.T, p *schema.Provider, typeName string) []*tfprotov5.Diagnostic {
+	t.Helper()
+
+	attributeTypes := map[string]tftypes.Type{
+		names.AttrID: tftypes.String,
+	}
+	for k := range p.ResourcesMap[typeName].SchemaMap() {
+		attributeTypes[k] = tftypes.Map{ElementType: tftypes.String}
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: attributeTypes,
+	}
+
+	values := map[string]tftypes.Value{
+		names.AttrID: tftypes.NewValue(tftypes.String, nil),
+		names.AttrTags: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Name": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	}
+	if _, ok := attributeTypes[names.AttrTagsAll]; ok {
+		values[names.AttrTagsAll] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+	}
+	config, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	priorState, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := NewProviderServer(p)().PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &priorState,
+		ProposedNewState: &config,
+		Config:           &config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.Diagnostics
+}
@@ -38,7 +38,7 @@ func wrapDataSource(r *schema.Resource, opts wrappedDataSourceOptions) {
 }
 
 func (w *wrappedDataSource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read, w.opts.typeName)
 }
 
 type wrappedResourceOptions struct {
@@ -72,27 +72,27 @@ func wrapResource(r *schema.Resource, opts wrappedResourceOptions) {
 }
 
 func (w *wrappedResource) create(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Create)
+	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Create, w.opts.typeName)
 }
 
 func (w *wrappedResource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read, w.opts.typeName)
 }
 
 func (w *wrappedResource) update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Update)
+	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Update, w.opts.typeName)
 }
 
 func (w *wrappedResource) delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete)
+	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete, w.opts.typeName)
 }
 
 func (w *wrappedResource) import_(f schema.StateContextFunc) schema.StateContextFunc {
-	return interceptedImportHandler(w.opts.bootstrapContext, w.opts.interceptors, f)
+	return interceptedImportHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName)
 }
 
 func (w *wrappedResource) customizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
-	return interceptedCustomizeDiffHandler(w.opts.bootstrapContext, w.opts.interceptors, f)
+	return interceptedCustomizeDiffHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName)
 }
 
 func (w *wrappedResource) stateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
@@ -67,8 +67,9 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // Cancellation of the passed in context will cancel the refresh loop.
 //
-// When VCR testing is enabled in replay mode, the DelayFunc is overridden to
-// allow interactions to be replayed with no delay between state change refreshes.
+// When VCR testing is enabled and recorded interactions are being replayed (REPLAY_ONLY
+// mode, or RECORD_IF_MISSING mode with an existing cassette), the DelayFunc is overridden
+// to allow interactions to be replayed with no delay between state change refreshes.
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
 	// Set a default for times to check for not found.
 	if conf.NotFoundChecks == 0 {
@@ -81,9 +82,9 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	// Set a default DelayFunc using the StateChangeConf values
 	delayFunc := backoff.SDKv2HelperRetryCompatibleDelay(conf.Delay, conf.PollInterval, conf.MinTimeout)
 
-	// When VCR testing in replay mode, override the default DelayFunc
+	// When VCR testing and replaying recorded interactions, override the default DelayFunc
 	if inContext, ok := conns.FromContext(ctx); ok && inContext.VCREnabled() {
-		if mode, _ := vcr.Mode(); mode == recorder.ModeReplayOnly {
+		if mode, _ := vcr.Mode(); mode == recorder.ModeReplayOnly || inContext.VCRReplaying() {
 			delayFunc = backoff.ZeroDelay
 		}
 	}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func recipeVersionErrorDetailsError(apiObjects []awstypes.RecipeVersionErrorDetail) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, recipeVersionErrorDetailError(apiObject))
+	}
+
+	return errors.Join(errs...)
+}
+
+func recipeVersionErrorDetailError(apiObject awstypes.RecipeVersionErrorDetail) error {
+	return fmt.Errorf("%s: %w", aws.ToString(apiObject.RecipeVersion), errs.APIError(aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+}
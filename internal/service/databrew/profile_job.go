@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_profile_job", name="Profile Job")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew;databrew.DescribeJobOutput")
+func newProfileJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &profileJobResource{}
+
+	return r, nil
+}
+
+type profileJobResource struct {
+	framework.ResourceWithModel[profileJobResourceModel]
+	framework.WithImportByID
+}
+
+func (r *profileJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: jobAttributes(map[string]schema.Attribute{
+			"dataset_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"column_statistics_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[columnStatisticsConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"selector":   columnSelectorBlock(ctx),
+									"statistics": statisticsConfigurationBlock(ctx, listvalidator.IsRequired(), listvalidator.SizeAtLeast(1)),
+								},
+							},
+						},
+						"dataset_statistics_configuration": statisticsConfigurationBlock(ctx),
+						"entity_detector_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityDetectorConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_types": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"allowed_statistics": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[allowedStatisticsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"statistics": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"profile_column": columnSelectorBlock(ctx),
+					},
+				},
+			},
+			"job_sample": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobSampleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrMode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SampleMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSize: schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"output_location": s3LocationBlock(ctx, listvalidator.IsRequired(), listvalidator.SizeAtLeast(1)),
+			"validation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ruleset_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"validation_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func columnSelectorBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Optional: true,
+				},
+				"regex": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func statisticsConfigurationBlock(ctx context.Context, validators ...validator.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[statisticsConfigurationModel](ctx),
+		Validators: append([]validator.List{
+			listvalidator.SizeAtMost(1),
+		}, validators...),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"included_statistics": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"override": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[statisticOverrideModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrParameters: schema.MapAttribute{
+								CustomType:  fwtypes.MapOfStringType,
+								ElementType: types.StringType,
+								Required:    true,
+							},
+							"statistic": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateProfileJobInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateProfileJob(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Profile Job (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(name)
+
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	output, err := findJobByTwoPartKey(ctx, conn, data.ID.ValueString(), awstypes.JobTypeProfile)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var input databrew.UpdateProfileJobInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateProfileJob(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Profile Job (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", name), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(new.flatten(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	if err := deleteJob(ctx, conn, data.ID.ValueString()); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Profile Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type profileJobResourceModel struct {
+	framework.WithRegionModel
+	Configuration            fwtypes.ListNestedObjectValueOf[profileConfigurationModel]    `tfsdk:"configuration"`
+	DatasetName              types.String                                                  `tfsdk:"dataset_name"`
+	EncryptionKeyARN         fwtypes.ARN                                                   `tfsdk:"encryption_key_arn"`
+	EncryptionMode           fwtypes.StringEnum[awstypes.EncryptionMode]                   `tfsdk:"encryption_mode"`
+	ID                       types.String                                                  `tfsdk:"id"`
+	JobSample                fwtypes.ListNestedObjectValueOf[jobSampleModel]               `tfsdk:"job_sample"`
+	LogSubscription          fwtypes.StringEnum[awstypes.LogSubscription]                  `tfsdk:"log_subscription"`
+	MaxCapacity              types.Int32                                                   `tfsdk:"max_capacity"`
+	MaxRetries               types.Int32                                                   `tfsdk:"max_retries"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	OutputLocation           fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"output_location"`
+	ResourceARN              types.String                                                  `tfsdk:"arn"`
+	RoleARN                  fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                     tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeout                  types.Int32                                                   `tfsdk:"timeout"`
+	ValidationConfigurations fwtypes.ListNestedObjectValueOf[validationConfigurationModel] `tfsdk:"validation_configuration"`
+}
+
+func (m *profileJobResourceModel) flatten(ctx context.Context, output *databrew.DescribeJobOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	jobSample := m.JobSample
+
+	diags.Append(fwflex.Flatten(ctx, output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The job description reports the configuration and output location under different names.
+	diags.Append(fwflex.Flatten(ctx, output.ProfileConfiguration, &m.Configuration)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(output.Outputs) > 0 {
+		diags.Append(fwflex.Flatten(ctx, output.Outputs[0].Location, &m.OutputLocation)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// A default job sample is reported if none is configured.
+	if jobSample.IsNull() {
+		m.JobSample = jobSample
+	}
+
+	return diags
+}
+
+type profileConfigurationModel struct {
+	ColumnStatisticsConfigurations fwtypes.ListNestedObjectValueOf[columnStatisticsConfigurationModel] `tfsdk:"column_statistics_configuration"`
+	DatasetStatisticsConfiguration fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel]       `tfsdk:"dataset_statistics_configuration"`
+	EntityDetectorConfiguration    fwtypes.ListNestedObjectValueOf[entityDetectorConfigurationModel]   `tfsdk:"entity_detector_configuration"`
+	ProfileColumns                 fwtypes.ListNestedObjectValueOf[columnSelectorModel]                `tfsdk:"profile_column"`
+}
+
+type columnStatisticsConfigurationModel struct {
+	Selectors  fwtypes.ListNestedObjectValueOf[columnSelectorModel]          `tfsdk:"selector"`
+	Statistics fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel] `tfsdk:"statistics"`
+}
+
+type columnSelectorModel struct {
+	Name  types.String `tfsdk:"name"`
+	Regex types.String `tfsdk:"regex"`
+}
+
+type statisticsConfigurationModel struct {
+	IncludedStatistics fwtypes.SetOfString                                     `tfsdk:"included_statistics"`
+	Overrides          fwtypes.ListNestedObjectValueOf[statisticOverrideModel] `tfsdk:"override"`
+}
+
+type statisticOverrideModel struct {
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+	Statistic  types.String        `tfsdk:"statistic"`
+}
+
+type entityDetectorConfigurationModel struct {
+	AllowedStatistics fwtypes.ListNestedObjectValueOf[allowedStatisticsModel] `tfsdk:"allowed_statistics"`
+	EntityTypes       fwtypes.SetOfString                                     `tfsdk:"entity_types"`
+}
+
+type allowedStatisticsModel struct {
+	Statistics fwtypes.SetOfString `tfsdk:"statistics"`
+}
+
+type jobSampleModel struct {
+	Mode fwtypes.StringEnum[awstypes.SampleMode] `tfsdk:"mode"`
+	Size types.Int64                             `tfsdk:"size"`
+}
+
+type validationConfigurationModel struct {
+	RulesetARN     fwtypes.ARN                                 `tfsdk:"ruleset_arn"`
+	ValidationMode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"validation_mode"`
+}
@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew;databrew.DescribeRecipeOutput")
+func newRecipeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recipeResource{}
+
+	return r, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+	framework.WithImportByID
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"published_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateRecipeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(name)
+
+	if data.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name, data.Description.ValueStringPointer()); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findRecipeByName(ctx, conn, name)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, conn, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	output, err := findRecipeByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, conn, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := new.Name.ValueString()
+	stepsChanged := !new.Description.Equal(old.Description) || !new.Steps.Equal(old.Steps)
+
+	if stepsChanged {
+		var input databrew.UpdateRecipeInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	// Changes are made to the working version and published as a new major version.
+	if new.Publish.ValueBool() && (stepsChanged || !old.Publish.ValueBool()) {
+		if err := publishRecipe(ctx, conn, name, new.Description.ValueStringPointer()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findRecipeByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(new.flatten(ctx, conn, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.ID.ValueString()
+
+	// The working version can only be deleted once all published versions are gone.
+	versions, err := findRecipeVersionsByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing DataBrew Recipe (%s) versions", name), err.Error())
+
+		return
+	}
+
+	for chunk := range slices.Chunk(versions, recipeVersionsMaxBatchSize) {
+		input := databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		}
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &input)
+
+		if err == nil && output != nil {
+			err = recipeVersionErrorDetailsError(output.Errors)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s) versions", name), err.Error())
+
+			return
+		}
+	}
+
+	input := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	_, err = conn.DeleteRecipeVersion(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+}
+
+const (
+	recipeVersionLatestPublished = "LATEST_PUBLISHED"
+	recipeVersionLatestWorking   = "LATEST_WORKING"
+
+	recipeVersionsMaxBatchSize = 50
+)
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name string, description *string) error {
+	input := databrew.PublishRecipeInput{
+		Description: description,
+		Name:        aws.String(name),
+	}
+
+	_, err := conn.PublishRecipe(ctx, &input)
+
+	return err
+}
+
+func findRecipeByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name: aws.String(name),
+	}
+
+	return findRecipe(ctx, conn, &input)
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+
+	return findRecipe(ctx, conn, &input)
+}
+
+func findRecipe(ctx context.Context, conn *databrew.Client, input *databrew.DescribeRecipeInput) (*databrew.DescribeRecipeOutput, error) {
+	output, err := conn.DescribeRecipe(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findRecipeVersionsByName returns the published versions of a recipe.
+func findRecipeVersionsByName(ctx context.Context, conn *databrew.Client, name string) ([]string, error) {
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []string
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Recipes {
+			if v := aws.ToString(v.RecipeVersion); v != "" && v != recipeVersionLatestWorking {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	Description      types.String                                     `tfsdk:"description"`
+	ID               types.String                                     `tfsdk:"id"`
+	Name             types.String                                     `tfsdk:"name"`
+	Publish          types.Bool                                       `tfsdk:"publish" autoflex:"-"`
+	PublishedVersion types.String                                     `tfsdk:"published_version" autoflex:"-"`
+	ResourceARN      types.String                                     `tfsdk:"arn"`
+	Steps            fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags             tftags.Map                                       `tfsdk:"tags"`
+	TagsAll          tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+func (m *recipeResourceModel) flatten(ctx context.Context, conn *databrew.Client, output *databrew.DescribeRecipeOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := aws.ToString(output.Name)
+	published, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestPublished)
+
+	switch {
+	case tfresource.NotFound(err):
+		m.PublishedVersion = types.StringNull()
+	case err != nil:
+		diags.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published version", name), err.Error())
+	default:
+		m.PublishedVersion = fwflex.StringToFramework(ctx, published.RecipeVersion)
+	}
+
+	// On import, infer whether the recipe is being published.
+	if m.Publish.IsNull() {
+		m.Publish = types.BoolValue(!m.PublishedVersion.IsNull())
+	}
+
+	return diags
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
@@ -4,6 +4,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -16,20 +17,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -37,7 +39,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -46,13 +48,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
@@ -426,6 +428,7 @@ func sweepMacSecKeys(region string) error {
 	// clean up the dangling resource is to use Secrets Manager to delete
 	// the MACsec key secret.
 	smConn := client.SecretsManagerClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := dxConn.DescribeConnections(ctx, input)
 
@@ -442,17 +445,35 @@ func sweepMacSecKeys(region string) error {
 		for _, v := range v.MacSecKeys {
 			arn := aws.ToString(v.SecretARN)
 
-			input := &secretsmanager.DeleteSecretInput{
-				SecretId: aws.String(arn),
-			}
+			sweepResources = append(sweepResources, sweep.WithDescription(macSecKeySecretSweeper{conn: smConn, arn: arn}, sweep.ResourceDescription{ARN: arn, ID: arn}))
+		}
+	}
 
-			log.Printf("[DEBUG] Deleting MACSec secret key: %s", arn)
-			_, err := smConn.DeleteSecret(ctx, input)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			if err != nil {
-				continue
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping Direct Connect MACsec Keys (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type macSecKeySecretSweeper struct {
+	conn *secretsmanager.Client
+	arn  string
+}
+
+func (mss macSecKeySecretSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	input := &secretsmanager.DeleteSecretInput{
+		SecretId: aws.String(mss.arn),
+	}
+
+	log.Printf("[DEBUG] Deleting MACSec secret key: %s", mss.arn)
+	_, err := mss.conn.DeleteSecret(ctx, input)
+
+	if err != nil {
+		// The secret may already be scheduled for deletion.
+		log.Printf("[WARN] Deleting MACSec secret key (%s): %s", mss.arn, err)
 	}
 
 	return nil
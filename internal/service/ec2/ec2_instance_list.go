@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_instance")
+func instanceResourceAsListResource() inttypes.ListResourceForSDKTypeName {
+	return &instanceListResource{}
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	tflog.Info(ctx, "Listing EC2 Instances")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// Terminated instances cannot be managed.
+		input := ec2.DescribeInstancesInput{
+			Filters: []awstypes.Filter{
+				newFilter("instance-state-name", enum.Slice(
+					awstypes.InstanceStateNamePending,
+					awstypes.InstanceStateNameRunning,
+					awstypes.InstanceStateNameShuttingDown,
+					awstypes.InstanceStateNameStopping,
+					awstypes.InstanceStateNameStopped,
+				)),
+			},
+		}
+		for item, err := range listInstances(ctx, conn, &input) {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Listing EC2 Instances", err.Error())
+				yield(result)
+				return
+			}
+
+			instanceID := aws.ToString(item.InstanceId)
+			ctx := tflog.SetField(ctx, "tf_aws.ec2_instance.id", instanceID)
+
+			d := l.ResourceData()
+			d.SetId(instanceID)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = instanceID
+			if v := aws.ToString(keyValueTags(ctx, item.Tags).KeyValue("Name")); v != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v, instanceID)
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, d, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listInstances(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstancesInput) iter.Seq2[awstypes.Instance, error] {
+	return func(yield func(awstypes.Instance, error) bool) {
+		pages := ec2.NewDescribeInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Instance{}, fmt.Errorf("listing EC2 Instances: %w", err))
+				return
+			}
+
+			for _, v := range page.Reservations {
+				for _, item := range v.Instances {
+					if !yield(item, nil) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInstanceListResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pages               []*ec2.DescribeInstancesOutput
+		err                 error
+		expectedDisplayName []string
+		expectedError       string
+	}{
+		"no instances": {
+			pages: []*ec2.DescribeInstancesOutput{{}},
+		},
+		"display names": {
+			pages: []*ec2.DescribeInstancesOutput{
+				{
+					Reservations: []awstypes.Reservation{{
+						Instances: []awstypes.Instance{
+							{
+								InstanceId: aws.String("i-0123456789abcdef0"),
+								Tags: []awstypes.Tag{{
+									Key:   aws.String("Name"),
+									Value: aws.String("web"),
+								}},
+							},
+							{
+								InstanceId: aws.String("i-0123456789abcdef1"),
+							},
+						},
+					}},
+					NextToken: aws.String("token"),
+				},
+				{
+					Reservations: []awstypes.Reservation{{
+						Instances: []awstypes.Instance{{
+							InstanceId: aws.String("i-0123456789abcdef2"),
+							Tags: []awstypes.Tag{{
+								Key:   aws.String("Environment"),
+								Value: aws.String("test"),
+							}},
+						}},
+					}},
+				},
+			},
+			expectedDisplayName: []string{
+				"web (i-0123456789abcdef0)",
+				"i-0123456789abcdef1",
+				"i-0123456789abcdef2",
+			},
+		},
+		"error after first page": {
+			pages: []*ec2.DescribeInstancesOutput{
+				{
+					Reservations: []awstypes.Reservation{{
+						Instances: []awstypes.Instance{{
+							InstanceId: aws.String("i-0123456789abcdef0"),
+						}},
+					}},
+					NextToken: aws.String("token"),
+				},
+			},
+			err:                 errors.New("UnauthorizedOperation"),
+			expectedDisplayName: []string{"i-0123456789abcdef0"},
+			expectedError:       "UnauthorizedOperation",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			var inputs []*ec2.DescribeInstancesInput
+			conn := ec2.New(ec2.Options{
+				Region:      endpoints.UsWest2RegionID,
+				Credentials: aws.AnonymousCredentials{},
+				APIOptions: []func(*middleware.Stack) error{
+					func(stack *middleware.Stack) error {
+						return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stub", func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							inputs = append(inputs, in.Parameters.(*ec2.DescribeInstancesInput))
+							if n := len(inputs); n <= len(testCase.pages) {
+								return middleware.InitializeOutput{Result: testCase.pages[n-1]}, middleware.Metadata{}, nil
+							}
+							return middleware.InitializeOutput{}, middleware.Metadata{}, testCase.err
+						}), middleware.Before)
+					},
+				},
+			})
+
+			results := testListInstances(ctx, t, conn)
+
+			// Terminated instances cannot be managed.
+			expectedFilters := []awstypes.Filter{{
+				Name:   aws.String("instance-state-name"),
+				Values: []string{"pending", "running", "shutting-down", "stopping", "stopped"},
+			}}
+			if diff := cmp.Diff(inputs[0].Filters, expectedFilters, cmp.AllowUnexported(awstypes.Filter{})); diff != "" {
+				t.Errorf("unexpected filters (-got +want):\n%s", diff)
+			}
+
+			var displayNames []string
+			for i, result := range results {
+				if result.Diagnostics.HasError() {
+					if i != len(results)-1 {
+						t.Errorf("result %d: error is not the last result", i)
+					}
+					if testCase.expectedError == "" {
+						t.Errorf("result %d: unexpected error: %v", i, result.Diagnostics)
+					} else if got := result.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, testCase.expectedError) {
+						t.Errorf("result %d: error %q does not contain %q", i, got, testCase.expectedError)
+					}
+					continue
+				}
+				displayNames = append(displayNames, result.DisplayName)
+			}
+			if testCase.expectedError != "" && !results[len(results)-1].Diagnostics.HasError() {
+				t.Errorf("expected error %q, got none", testCase.expectedError)
+			}
+			if diff := cmp.Diff(displayNames, testCase.expectedDisplayName); diff != "" {
+				t.Errorf("unexpected display names (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func testListInstances(ctx context.Context, t *testing.T, conn *ec2.Client) []list.ListResult {
+	t.Helper()
+
+	awsClient := &conns.AWSClient{}
+	conns.SetAWSConfig(awsClient, &aws.Config{Region: endpoints.UsWest2RegionID})
+	conns.SetAPIClient(awsClient, endpoints.UsWest2RegionID, names.EC2, conn)
+
+	identitySpec := inttypes.RegionalSingleParameterIdentity(names.AttrID)
+	r := resourceInstance()
+	r.Identity = &sdkschema.ResourceIdentity{
+		SchemaFunc: func() map[string]*sdkschema.Schema {
+			return identity.NewIdentitySchema(identitySpec)
+		},
+	}
+
+	l := &instanceListResource{}
+	l.SetResourceSchema(r)
+	l.SetIdentitySpec(identitySpec)
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: awsClient}, &resource.ConfigureResponse{})
+
+	request := list.ListRequest{
+		ResourceSchema:         schema.Schema{},
+		ResourceIdentitySchema: identityschema.Schema{},
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, request, &stream)
+
+	return slices.Collect(stream.Results)
+}
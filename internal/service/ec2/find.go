@@ -15,6 +15,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -2079,13 +2080,16 @@ func findSecurityGroupIngressRuleByID(ctx context.Context, conn *ec2.Client, id
 }
 
 func findSecurityGroupRulesBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.SecurityGroupRule, error) {
-	input := ec2.DescribeSecurityGroupRulesInput{
-		Filters: newAttributeFilterList(map[string]string{
-			"group-id": id,
-		}),
-	}
+	// One listing of the security group's rules serves the reads of all its rules.
+	return conns.CachedRead(ctx, names.EC2, "DescribeSecurityGroupRules", id, func(ctx context.Context) ([]awstypes.SecurityGroupRule, error) {
+		input := ec2.DescribeSecurityGroupRulesInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"group-id": id,
+			}),
+		}
 
-	return findSecurityGroupRules(ctx, conn, &input)
+		return findSecurityGroupRules(ctx, conn, &input)
+	})
 }
 
 func findSecurityGroupVPCAssociationByTwoPartKey(ctx context.Context, conn *ec2.Client, groupID, vpcID string) (*awstypes.SecurityGroupVpcAssociation, error) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,16 +17,18 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -33,17 +36,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -51,17 +54,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -69,7 +72,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -77,12 +80,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -91,17 +94,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -109,7 +112,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -119,7 +122,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -127,7 +130,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -138,7 +141,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -147,17 +150,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -176,7 +179,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -186,12 +189,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -203,12 +206,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -216,12 +219,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -276,7 +279,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -284,12 +287,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -297,17 +300,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -319,12 +322,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -333,7 +336,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -342,12 +345,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -357,12 +360,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -370,12 +373,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -396,12 +399,12 @@ func RegisterSweepers() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -414,22 +417,22 @@ func RegisterSweepers() {
 	awsv2.Register("aws_vpc_ipam", sweepIPAMs)
 	awsv2.Register("aws_vpc_ipam_resource_discovery", sweepIPAMResourceDiscoveries)
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -437,7 +440,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -445,7 +448,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -453,12 +456,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
@@ -493,27 +496,24 @@ func sweepCapacityReservations(region string) error {
 		return fmt.Errorf("Error retrieving EC2 Capacity Reservations: %s", err)
 	}
 
-	if len(resp.CapacityReservations) == 0 {
-		log.Print("[DEBUG] No EC2 Capacity Reservations to sweep")
-		return nil
-	}
+	var sweepResources []sweep.Sweepable
 
-	for _, r := range resp.CapacityReservations {
-		if r.State != awstypes.CapacityReservationStateCancelled && r.State != awstypes.CapacityReservationStateExpired {
-			id := aws.ToString(r.CapacityReservationId)
+	for _, v := range resp.CapacityReservations {
+		if v.State == awstypes.CapacityReservationStateCancelled || v.State == awstypes.CapacityReservationStateExpired {
+			continue
+		}
 
-			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
+		r := resourceCapacityReservation()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.CapacityReservationId))
 
-			input := ec2.CancelCapacityReservationInput{
-				CapacityReservationId: aws.String(id),
-			}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
 
-			_, err := conn.CancelCapacityReservation(ctx, &input)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			if err != nil {
-				log.Printf("[ERROR] Error cancelling EC2 Capacity Reservation (%s): %s", id, err)
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservations (%s): %w", region, err)
 	}
 
 	return nil
@@ -1046,15 +1046,11 @@ func sweepInstances(region string) error {
 					continue
 				}
 
-				if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
-					log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
-				}
-
 				r := resourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, newInstanceSweeper(conn, r, d, client))
 			}
 		}
 	}
@@ -1068,6 +1064,32 @@ func sweepInstances(region string) error {
 	return nil
 }
 
+type instanceSweeper struct {
+	conn      *ec2.Client
+	d         *schema.ResourceData
+	sweepable sweep.Sweepable
+}
+
+func newInstanceSweeper(conn *ec2.Client, resource *schema.Resource, d *schema.ResourceData, client *conns.AWSClient) *instanceSweeper {
+	return &instanceSweeper{
+		conn:      conn,
+		d:         d,
+		sweepable: sweep.NewSweepResource(resource, d, client),
+	}
+}
+
+func (is instanceSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	if err := disableInstanceAPIStop(ctx, is.conn, is.d.Id(), false); err != nil {
+		log.Printf("[INFO] EC2 Instance (%s): %s", is.d.Id(), err)
+	}
+
+	return is.sweepable.Delete(ctx, optFns...)
+}
+
+func (is instanceSweeper) Unwrap() sweep.Sweepable {
+	return is.sweepable
+}
+
 func sweepInternetGateways(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -1502,8 +1524,8 @@ func sweepRouteTables(region string) error {
 	}
 
 	conn := client.EC2Client(ctx)
-	var sweeperErrs *multierror.Error
 	input := ec2.DescribeRouteTablesInput{}
+	var sweepResources []sweep.Sweepable
 
 	pages := ec2.NewDescribeRouteTablesPaginator(conn, &input)
 	for pages.HasMorePages() {
@@ -1511,85 +1533,75 @@ func sweepRouteTables(region string) error {
 
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
+			return nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Route Tables: %w", err))
+			return fmt.Errorf("error listing EC2 Route Tables (%s): %w", region, err)
 		}
 
-		for _, routeTable := range page.RouteTables {
-			id := aws.ToString(routeTable.RouteTableId)
-			isMainRouteTableAssociation := false
-
-			for _, routeTableAssociation := range routeTable.Associations {
-				if aws.ToBool(routeTableAssociation.Main) {
-					isMainRouteTableAssociation = true
-					break
-				}
+		for _, v := range page.RouteTables {
+			id := aws.ToString(v.RouteTableId)
 
-				associationID := aws.ToString(routeTableAssociation.RouteTableAssociationId)
+			if slices.ContainsFunc(v.Associations, func(v awstypes.RouteTableAssociation) bool {
+				return aws.ToBool(v.Main)
+			}) {
+				// The main route table is deleted with its VPC, so only its routes are swept.
+				sweepResources = append(sweepResources, sweep.WithDescription(mainRouteTableRoutesSweeper{conn: conn, routeTable: v}, sweep.ResourceDescription{
+					ID:   id,
+					Tags: keyValueTags(ctx, v.Tags).Map(),
+				}))
+				continue
+			}
 
-				input := ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
+			r := resourceRouteTable()
+			d := r.Data(nil)
+			d.SetId(id)
 
-				log.Printf("[DEBUG] Deleting EC2 Route Table Association: %s", associationID)
-				_, err := conn.DisassociateRouteTable(ctx, &input)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
 
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Association (%s): %w", id, associationID, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-			}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			if isMainRouteTableAssociation {
-				for _, route := range routeTable.Routes {
-					if gatewayID := aws.ToString(route.GatewayId); gatewayID == gatewayIDLocal || gatewayID == gatewayIDVPCLattice {
-						continue
-					}
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Route Tables (%s): %w", region, err)
+	}
 
-					// Prevent deleting default VPC route for Internet Gateway
-					// which some testing is still reliant on operating correctly
-					if strings.HasPrefix(aws.ToString(route.GatewayId), "igw-") && aws.ToString(route.DestinationCidrBlock) == "0.0.0.0/0" {
-						continue
-					}
+	return nil
+}
 
-					input := ec2.DeleteRouteInput{
-						DestinationCidrBlock:     route.DestinationCidrBlock,
-						DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
-						RouteTableId:             routeTable.RouteTableId,
-					}
+type mainRouteTableRoutesSweeper struct {
+	conn       *ec2.Client
+	routeTable awstypes.RouteTable
+}
 
-					log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
-					_, err := conn.DeleteRoute(ctx, &input)
+func (s mainRouteTableRoutesSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	id := aws.ToString(s.routeTable.RouteTableId)
+	var sweeperErrs *multierror.Error
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
+	for _, route := range s.routeTable.Routes {
+		if gatewayID := aws.ToString(route.GatewayId); gatewayID == gatewayIDLocal || gatewayID == gatewayIDVPCLattice {
+			continue
+		}
 
-				continue
-			}
+		// Prevent deleting default VPC route for Internet Gateway
+		// which some testing is still reliant on operating correctly
+		if strings.HasPrefix(aws.ToString(route.GatewayId), "igw-") && aws.ToString(route.DestinationCidrBlock) == "0.0.0.0/0" {
+			continue
+		}
 
-			input := ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
-			}
+		input := ec2.DeleteRouteInput{
+			DestinationCidrBlock:     route.DestinationCidrBlock,
+			DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
+			RouteTableId:             aws.String(id),
+		}
 
-			log.Printf("[DEBUG] Deleting EC2 Route Table: %s", id)
-			_, err := conn.DeleteRouteTable(ctx, &input)
+		log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
+		_, err := s.conn.DeleteRoute(ctx, &input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err))
 		}
 	}
 
@@ -1606,8 +1618,8 @@ func sweepSecurityGroups(region string) error {
 
 	conn := client.EC2Client(ctx)
 	input := ec2.DescribeSecurityGroupsInput{}
+	var ruleSweepResources, sweepResources []sweep.Sweepable
 
-	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
 	pages := ec2.NewDescribeSecurityGroupsPaginator(conn, &input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -1621,76 +1633,103 @@ func sweepSecurityGroups(region string) error {
 			return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
 		}
 
-		for _, sg := range page.SecurityGroups {
-			if aws.ToString(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.ToString(sg.GroupId))
+		for _, v := range page.SecurityGroups {
+			id := aws.ToString(v.GroupId)
+
+			if aws.ToString(v.GroupName) == "default" {
+				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", id)
 				continue
 			}
 
-			if sg.IpPermissions != nil {
-				input := ec2.RevokeSecurityGroupIngressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissions,
-				}
-
-				if _, err = conn.RevokeSecurityGroupIngress(ctx, &input); err != nil {
-					log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", aws.ToString(sg.GroupId), err)
-				}
+			description := sweep.ResourceDescription{
+				ARN:  aws.ToString(v.SecurityGroupArn),
+				ID:   id,
+				Tags: keyValueTags(ctx, v.Tags).Map(),
 			}
 
-			if sg.IpPermissionsEgress != nil {
-				input := ec2.RevokeSecurityGroupEgressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissionsEgress,
-				}
-
-				if _, err = conn.RevokeSecurityGroupEgress(ctx, &input); err != nil {
-					log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", aws.ToString(sg.GroupId), err)
-				}
-			}
+			ruleSweepResources = append(ruleSweepResources, sweep.WithDescription(securityGroupRulesSweeper{conn: conn, securityGroup: v}, description))
+			sweepResources = append(sweepResources, sweep.WithDescription(securityGroupSweeper{conn: conn, id: id}, description))
 		}
 	}
 
-	pages = ec2.NewDescribeSecurityGroupsPaginator(conn, &input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	// Revoke all non-default EC2 Security Group Rules first to prevent DependencyViolation errors
+	err = sweep.SweepOrchestrator(sweep.WithResourceType(ctx, "aws_security_group_rule"), ruleSweepResources)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
-			return nil
+	if err != nil {
+		log.Printf("[ERROR] Error revoking EC2 Security Group rules (%s): %s", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Security Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type securityGroupRulesSweeper struct {
+	conn          *ec2.Client
+	securityGroup awstypes.SecurityGroup
+}
+
+func (s securityGroupRulesSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	var sweeperErrs *multierror.Error
+
+	if s.securityGroup.IpPermissions != nil {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       s.securityGroup.GroupId,
+			IpPermissions: s.securityGroup.IpPermissions,
 		}
 
-		if err != nil {
-			return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
+		if _, err := s.conn.RevokeSecurityGroupIngress(ctx, &input); err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error revoking ingress rules for Security Group (%s): %w", aws.ToString(s.securityGroup.GroupId), err))
 		}
+	}
 
-		for _, sg := range page.SecurityGroups {
-			if aws.ToString(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.ToString(sg.GroupId))
-				continue
-			}
+	if s.securityGroup.IpPermissionsEgress != nil {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       s.securityGroup.GroupId,
+			IpPermissions: s.securityGroup.IpPermissionsEgress,
+		}
 
-			input := ec2.DeleteSecurityGroupInput{
-				GroupId: sg.GroupId,
-			}
+		if _, err := s.conn.RevokeSecurityGroupEgress(ctx, &input); err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error revoking egress rules for Security Group (%s): %w", aws.ToString(s.securityGroup.GroupId), err))
+		}
+	}
 
-			// Handle EC2 eventual consistency
-			err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
-				_, err := conn.DeleteSecurityGroup(ctx, &input)
+	return sweeperErrs.ErrorOrNil()
+}
 
-				if tfawserr.ErrCodeEquals(err, "DependencyViolation") {
-					return retry.RetryableError(err)
-				}
-				if err != nil {
-					return retry.NonRetryableError(err)
-				}
-				return nil
-			})
+type securityGroupSweeper struct {
+	conn *ec2.Client
+	id   string
+}
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Security Group (%s): %s", aws.ToString(sg.GroupId), err)
-			}
+func (s securityGroupSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	input := ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(s.id),
+	}
+
+	// Handle EC2 eventual consistency
+	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		_, err := s.conn.DeleteSecurityGroup(ctx, &input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeDependencyViolation) {
+			return retry.RetryableError(err)
+		}
+		if err != nil {
+			return retry.NonRetryableError(err)
 		}
+		return nil
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Group (%s): %w", s.id, err)
 	}
 
 	return nil
@@ -267,7 +267,9 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	securityGroupID := d.Get("security_group_id").(string)
 	ruleType := securityGroupRuleType(d.Get(names.AttrType).(string))
 
-	sg, err := findSecurityGroupByID(ctx, conn, securityGroupID)
+	sg, err := conns.CachedRead(ctx, names.EC2, "DescribeSecurityGroups", securityGroupID, func(ctx context.Context) (*awstypes.SecurityGroup, error) {
+		return findSecurityGroupByID(ctx, conn, securityGroupID)
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", securityGroupID)
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -28,7 +29,7 @@ const (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -36,12 +37,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -50,7 +51,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -58,7 +59,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -67,7 +68,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
 		Name: "aws_elasticache_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -75,12 +76,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
 		Name: "aws_elasticache_user_group",
 		F:    sweepUserGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
 		Name: "aws_elasticache_serverless_cache",
 		F:    sweepServerlessCaches,
 	})
@@ -96,7 +97,7 @@ func sweepClusters(region string) error {
 		ShowCacheClustersNotInReplicationGroups: aws.Bool(true),
 	}
 	conn := client.ElastiCacheClient(ctx)
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := elasticache.NewDescribeCacheClustersPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -104,35 +105,30 @@ func sweepClusters(region string) error {
 
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping ElastiCache Cluster sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving ElastiCache Clusters: %w", err))
+			return fmt.Errorf("error listing ElastiCache Clusters (%s): %w", region, err)
 		}
 
 		for _, v := range page.CacheClusters {
-			id := aws.ToString(v.CacheClusterId)
+			r := resourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.CacheClusterId))
+			d.Set(names.AttrARN, v.ARN)
 
-			log.Printf("[INFO] Deleting ElastiCache Cluster: %s", id)
-			err := deleteCacheCluster(ctx, conn, id, "")
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Cluster (%s): %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err))
-			}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			const (
-				timeout = 40 * time.Minute
-			)
-			if _, err := waitCacheClusterDeleted(ctx, conn, id, timeout); err != nil {
-				log.Printf("[ERROR] Failed waiting for ElastiCache Cache Cluster (%s) to be deleted: %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err))
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Clusters (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepGlobalReplicationGroups(region string) error {
@@ -145,9 +141,7 @@ func sweepGlobalReplicationGroups(region string) error {
 		ShowMemberInfo: aws.Bool(true),
 	}
 	conn := client.ElastiCacheClient(ctx)
-
-	var grgGroup multierror.Group
-	var grgErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := elasticache.NewDescribeGlobalReplicationGroupsPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -155,33 +149,44 @@ func sweepGlobalReplicationGroups(region string) error {
 
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %q: %s", region, err)
-			return grgErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 
 		if err != nil {
-			grgErrs = multierror.Append(grgErrs, fmt.Errorf("listing ElastiCache Global Replication Groups: %w", err))
+			return fmt.Errorf("listing ElastiCache Global Replication Groups (%s): %w", region, err)
 		}
 
-		for _, globalReplicationGroup := range page.GlobalReplicationGroups {
-			grgGroup.Go(func() error {
-				id := aws.ToString(globalReplicationGroup.GlobalReplicationGroupId)
-
-				disassociationErrors := disassociateMembers(ctx, conn, globalReplicationGroup)
-				if disassociationErrors != nil {
-					return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, disassociationErrors)
-				}
+		for _, v := range page.GlobalReplicationGroups {
+			sweepResources = append(sweepResources, sweep.WithDescription(globalReplicationGroupSweeper{conn: conn, globalReplicationGroup: v}, sweep.ResourceDescription{
+				ARN: aws.ToString(v.ARN),
+				ID:  aws.ToString(v.GlobalReplicationGroupId),
+			}))
+		}
+	}
 
-				log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
-				err := deleteGlobalReplicationGroup(ctx, conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-				return err
-			})
-		}
+	if err != nil {
+		return fmt.Errorf("sweeping ElastiCache Global Replication Groups (%s): %w", region, err)
 	}
 
-	grgErrs = multierror.Append(grgErrs, grgGroup.Wait())
+	return nil
+}
+
+type globalReplicationGroupSweeper struct {
+	conn                   *elasticache.Client
+	globalReplicationGroup awstypes.GlobalReplicationGroup
+}
+
+func (s globalReplicationGroupSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	id := aws.ToString(s.globalReplicationGroup.GlobalReplicationGroupId)
+
+	if err := disassociateMembers(ctx, s.conn, s.globalReplicationGroup); err != nil {
+		return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, err)
+	}
 
-	return grgErrs.ErrorOrNil()
+	log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
+	return deleteGlobalReplicationGroup(ctx, s.conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
 }
 
 func sweepParameterGroups(region string) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_fms_admin_account", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fms_admin_account", &resource.Sweeper{
 		Name: "aws_fms_admin_account",
 		F:    sweepAdminAccount,
 	})
@@ -111,3 +111,7 @@ func (aas adminAccountSweeper) Delete(ctx context.Context, optFns ...tfresource.
 	}
 	return err
 }
+
+func (aas adminAccountSweeper) Unwrap() sweep.Sweepable {
+	return aas.sweepable
+}
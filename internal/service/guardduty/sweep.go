@@ -4,25 +4,29 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
-	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -38,7 +42,7 @@ func sweepDetectors(region string) error {
 
 	conn := client.GuardDutyClient(ctx)
 	input := &guardduty.ListDetectorsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := guardduty.NewListDetectorsPaginator(conn, input)
 
@@ -55,25 +59,48 @@ func sweepDetectors(region string) error {
 		}
 
 		for _, detectorID := range page.DetectorIds {
-			input := &guardduty.DeleteDetectorInput{
-				DetectorId: &detectorID,
-			}
+			r := resourceDetector()
+			d := r.Data(nil)
+			d.SetId(detectorID)
 
-			log.Printf("[INFO] Deleting GuardDuty Detector: %s", detectorID)
-			_, err := conn.DeleteDetector(ctx, input)
-			if tfawserr.ErrCodeContains(err, "AccessDenied") {
-				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", detectorID, err)
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting GuardDuty Detector (%s): %w", detectorID, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, newDetectorSweeper(r, d, client))
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type detectorSweeper struct {
+	d         *schema.ResourceData
+	sweepable sweep.Sweepable
+}
+
+func newDetectorSweeper(resource *schema.Resource, d *schema.ResourceData, client *conns.AWSClient) *detectorSweeper {
+	return &detectorSweeper{
+		d:         d,
+		sweepable: sweep.NewSweepResource(resource, d, client),
+	}
+}
+
+func (ds detectorSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	if err := ds.sweepable.Delete(ctx, optFns...); err != nil {
+		if strings.Contains(err.Error(), "AccessDenied") {
+			log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", ds.d.Id(), err)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (ds detectorSweeper) Unwrap() sweep.Sweepable {
+	return ds.sweepable
 }
 
 func sweepPublishingDestinations(region string) error {
@@ -85,7 +112,7 @@ func sweepPublishingDestinations(region string) error {
 	}
 
 	conn := client.GuardDutyClient(ctx)
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	detect_input := &guardduty.ListDetectorsInput{}
 
@@ -94,10 +121,13 @@ func sweepPublishingDestinations(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
 		if err != nil {
-			sweeperErr := fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			return fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
 		}
 
 		for _, detectorID := range page.DetectorIds {
@@ -120,23 +150,21 @@ func sweepPublishingDestinations(region string) error {
 				}
 
 				for _, destination_element := range page.Destinations {
-					input := &guardduty.DeletePublishingDestinationInput{
-						DestinationId: destination_element.DestinationId,
-						DetectorId:    &detectorID,
-					}
-
-					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestination(ctx, input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+					r := resourcePublishingDestination()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s:%s", detectorID, aws.ToString(destination_element.DestinationId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 			}
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Publishing Destinations (%s): %w", region, err)
+	}
+
+	return nil
 }
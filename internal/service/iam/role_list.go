@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_iam_role")
+func roleResourceAsListResource() inttypes.ListResourceForSDKTypeName {
+	return &roleListResource{}
+}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.IAMClient(ctx)
+
+	tflog.Info(ctx, "Listing IAM Roles")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input iam.ListRolesInput
+		for item, err := range listRoles(ctx, conn, &input) {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Listing IAM Roles", err.Error())
+				yield(result)
+				return
+			}
+
+			roleName := aws.ToString(item.RoleName)
+			ctx := tflog.SetField(ctx, "tf_aws.iam_role.name", roleName)
+
+			d := l.ResourceData()
+			d.SetId(roleName)
+			d.Set(names.AttrName, roleName)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = roleName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, d, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listRoles(ctx context.Context, conn *iam.Client, input *iam.ListRolesInput) iter.Seq2[awstypes.Role, error] {
+	return func(yield func(awstypes.Role, error) bool) {
+		pages := iam.NewListRolesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Role{}, fmt.Errorf("listing IAM Roles: %w", err))
+				return
+			}
+
+			for _, item := range page.Roles {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -141,23 +141,22 @@ func detachPolicyFromRole(ctx context.Context, conn *iam.Client, role, policyARN
 }
 
 func findAttachedRolePolicyByTwoPartKey(ctx context.Context, conn *iam.Client, roleName, policyARN string) (*awstypes.AttachedPolicy, error) {
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
+	// One listing of the role's attached policies serves the reads of all its attachments.
+	output, err := conns.CachedRead(ctx, names.IAM, "ListAttachedRolePolicies", roleName, func(ctx context.Context) ([]awstypes.AttachedPolicy, error) {
+		input := &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(roleName),
+		}
 
-	return findAttachedRolePolicy(ctx, conn, input, func(v awstypes.AttachedPolicy) bool {
-		return aws.ToString(v.PolicyArn) == policyARN
+		return findAttachedRolePolicies(ctx, conn, input, tfslices.PredicateTrue[awstypes.AttachedPolicy]())
 	})
-}
-
-func findAttachedRolePolicy(ctx context.Context, conn *iam.Client, input *iam.ListAttachedRolePoliciesInput, filter tfslices.Predicate[awstypes.AttachedPolicy]) (*awstypes.AttachedPolicy, error) {
-	output, err := findAttachedRolePolicies(ctx, conn, input, filter)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return tfresource.AssertSingleValueResult(output)
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v awstypes.AttachedPolicy) bool {
+		return aws.ToString(v.PolicyArn) == policyARN
+	}))
 }
 
 func findAttachedRolePolicies(ctx context.Context, conn *iam.Client, input *iam.ListAttachedRolePoliciesInput, filter tfslices.Predicate[awstypes.AttachedPolicy]) ([]awstypes.AttachedPolicy, error) {
@@ -401,6 +401,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*inttypes.ServicePackageSDKListResource {
+	return []*inttypes.ServicePackageSDKListResource{
+		{
+			Factory:  roleResourceAsListResource,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrName,
+				ResourceType:        "Role",
+			}),
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalSingleParameterIdentity(names.AttrName),
+		},
+	}
+}
+
 func (p *servicePackage) ServicePackageName() string {
 	return names.IAM
 }
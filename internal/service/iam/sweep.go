@@ -28,7 +28,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_auditmanager_assessment",
@@ -92,14 +92,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -122,18 +122,18 @@ func sweepGroups(region string) error {
 
 	conn := client.IAMClient(ctx)
 	input := &iam.ListGroupsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := iam.NewListGroupsPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
+			return nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Groups: %w", err))
+			return fmt.Errorf("error retrieving IAM Groups: %w", err)
 		}
 
 		for _, group := range page.Groups {
@@ -143,85 +143,89 @@ func sweepGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Group: %s", name)
-
-			getGroupInput := &iam.GetGroupInput{
-				GroupName: group.GroupName,
-			}
+			sweepResources = append(sweepResources, sweep.WithDescription(groupSweeper{conn: conn, name: name}, sweep.ResourceDescription{
+				ARN:       aws.ToString(group.Arn),
+				CreatedAt: aws.ToTime(group.CreateDate),
+				ID:        name,
+			}))
+		}
+	}
 
-			getGroupOutput, err := conn.GetGroup(ctx, getGroupInput)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-			if errs.IsA[*awstypes.NoSuchEntityException](err) {
-				continue
-			}
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Groups (%s): %w", region, err)
+	}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+	return nil
+}
 
-			if getGroupOutput != nil {
-				for _, user := range getGroupOutput.Users {
-					username := aws.ToString(user.UserName)
+type groupSweeper struct {
+	conn *iam.Client
+	name string
+}
 
-					log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+func (gs groupSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	log.Printf("[INFO] Deleting IAM Group: %s", gs.name)
 
-					input := &iam.RemoveUserFromGroupInput{
-						UserName:  user.UserName,
-						GroupName: group.GroupName,
-					}
+	getGroupInput := &iam.GetGroupInput{
+		GroupName: aws.String(gs.name),
+	}
 
-					_, err := conn.RemoveUserFromGroup(ctx, input)
+	getGroupOutput, err := gs.conn.GetGroup(ctx, getGroupInput)
 
-					if errs.IsA[*awstypes.NoSuchEntityException](err) {
-						continue
-					}
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil
+	}
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
-			}
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s): %w", gs.name, err)
+	}
 
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
+	for _, user := range getGroupOutput.Users {
+		username := aws.ToString(user.UserName)
 
-			if err := deleteGroupPolicyAttachments(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, gs.name)
 
-			if err := deleteGroupPolicies(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		input := &iam.RemoveUserFromGroupInput{
+			UserName:  user.UserName,
+			GroupName: aws.String(gs.name),
+		}
 
-			_, err = conn.DeleteGroup(ctx, input)
+		_, err := gs.conn.RemoveUserFromGroup(ctx, input)
 
-			if errs.IsA[*awstypes.NoSuchEntityException](err) {
-				continue
-			}
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if err != nil {
+			return fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, gs.name, err)
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := deleteGroupPolicyAttachments(ctx, gs.conn, gs.name); err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", gs.name, err)
+	}
+
+	if err := deleteGroupPolicies(ctx, gs.conn, gs.name); err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s) policies: %w", gs.name, err)
+	}
+
+	input := &iam.DeleteGroupInput{
+		GroupName: aws.String(gs.name),
+	}
+
+	_, err = gs.conn.DeleteGroup(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s): %w", gs.name, err)
+	}
+
+	return nil
 }
 
 func sweepInstanceProfile(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -420,6 +424,10 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 	return nil
 }
 
+func (ps policySweeper) Unwrap() sweep.Sweepable {
+	return ps.sweepable
+}
+
 func sweepRoles(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -427,8 +435,8 @@ func sweepRoles(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.IAMClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	roles := make([]string, 0)
 	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -443,39 +451,48 @@ func sweepRoles(region string) error {
 
 		for _, role := range page.Roles {
 			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
+			if !roleNameFilter(roleName) {
 				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+				continue
 			}
+
+			sweepResources = append(sweepResources, sweep.WithDescription(roleSweeper{conn: conn, name: roleName}, sweep.ResourceDescription{
+				ARN:       aws.ToString(role.Arn),
+				CreatedAt: aws.ToTime(role.CreateDate),
+				ID:        roleName,
+			}))
 		}
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Roles (%s): %w", region, err)
 	}
 
-	var sweeperErrs *multierror.Error
+	return nil
+}
 
-	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+type roleSweeper struct {
+	conn *iam.Client
+	name string
+}
 
-		err := deleteRole(ctx, conn, roleName, true, true, true)
+func (rs roleSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	log.Printf("[DEBUG] Deleting IAM Role (%s)", rs.name)
 
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	err := deleteRole(ctx, rs.conn, rs.name, true, true, true)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping IAM Role (%s): %s", rs.name, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Role (%s): %w", rs.name, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -508,6 +525,7 @@ func sweepServerCertificates(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.IAMClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := iam.NewListServerCertificatesPaginator(conn, &iam.ListServerCertificatesInput{})
 	for pages.HasMorePages() {
@@ -522,19 +540,43 @@ func sweepServerCertificates(region string) error {
 		}
 
 		for _, sc := range page.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
-
-			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					aws.ToString(sc.ServerCertificateName), err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.WithDescription(serverCertificateSweeper{conn: conn, name: aws.ToString(sc.ServerCertificateName)}, sweep.ResourceDescription{
+				ARN:       aws.ToString(sc.Arn),
+				CreatedAt: aws.ToTime(sc.UploadDate),
+				ID:        aws.ToString(sc.ServerCertificateId),
+			}))
 		}
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Server Certificates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type serverCertificateSweeper struct {
+	conn *iam.Client
+	name string
+}
+
+func (scs serverCertificateSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	log.Printf("[INFO] Deleting IAM Server Certificate: %s", scs.name)
+
+	_, err := scs.conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
+		ServerCertificateName: aws.String(scs.name),
+	})
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Server Certificate (%s): %w", scs.name, err)
+	}
+
 	return nil
 }
 
@@ -33,6 +33,7 @@ import (
 
 // @FrameworkResource("aws_iot_billing_group", name="Billing Group")
 // @Tags(identifierAttribute="arn")
+// @Testing(migrationTest=true, migrationTestVersion="5.74.0")
 func newBillingGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &billingGroupResource{}
 
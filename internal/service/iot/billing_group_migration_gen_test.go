@@ -0,0 +1,30 @@
+// Code generated by internal/generate/migrationtests/main.go; DO NOT EDIT.
+
+package iot_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTBillingGroup_migrateFromPluginSDK_stateCompatibility(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName := "aws_iot_billing_group.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.MigrationTest(ctx, t, acctest.MigrationTestCase{
+		PreCheck:        func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:      acctest.ErrorCheck(t, names.IoTServiceID),
+		CheckDestroy:    testAccCheckBillingGroupDestroy(ctx),
+		ConfigDirectory: config.StaticDirectory("testdata/BillingGroup/migration/"),
+		ConfigVariables: config.Variables{
+			acctest.CtRName: config.StringVariable(rName),
+		},
+		ResourceName:    resourceName,
+		ProviderVersion: "5.74.0",
+	})
+}
@@ -4,6 +4,7 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOpPaginated -ServiceTagsSlice -UpdateTags
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/identitytests/main.go
+//go:generate go run ../../generate/migrationtests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package iot
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_lambda_function")
+func functionResourceAsListResource() inttypes.ListResourceForSDKTypeName {
+	return &functionListResource{}
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	tflog.Info(ctx, "Listing Lambda Functions")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lambda.ListFunctionsInput
+		for item, err := range listFunctions(ctx, conn, &input) {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Listing Lambda Functions", err.Error())
+				yield(result)
+				return
+			}
+
+			functionName := aws.ToString(item.FunctionName)
+			ctx := tflog.SetField(ctx, "tf_aws.lambda_function.function_name", functionName)
+
+			d := l.ResourceData()
+			d.SetId(functionName)
+			d.Set("function_name", functionName)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = functionName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, d, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listFunctions(ctx context.Context, conn *lambda.Client, input *lambda.ListFunctionsInput) iter.Seq2[awstypes.FunctionConfiguration, error] {
+	return func(yield func(awstypes.FunctionConfiguration, error) bool) {
+		pages := lambda.NewListFunctionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.FunctionConfiguration{}, fmt.Errorf("listing Lambda Functions: %w", err))
+				return
+			}
+
+			for _, item := range page.Functions {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFunctionListResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pages               []*lambda.ListFunctionsOutput
+		err                 error
+		expectedDisplayName []string
+		expectedError       string
+	}{
+		"no functions": {
+			pages: []*lambda.ListFunctionsOutput{{}},
+		},
+		"display names": {
+			pages: []*lambda.ListFunctionsOutput{
+				{
+					Functions: []awstypes.FunctionConfiguration{
+						{FunctionName: aws.String("function-a")},
+						{FunctionName: aws.String("function-b")},
+					},
+					NextMarker: aws.String("marker"),
+				},
+				{
+					Functions: []awstypes.FunctionConfiguration{
+						{FunctionName: aws.String("function-c")},
+					},
+				},
+			},
+			expectedDisplayName: []string{"function-a", "function-b", "function-c"},
+		},
+		"error after first page": {
+			pages: []*lambda.ListFunctionsOutput{
+				{
+					Functions: []awstypes.FunctionConfiguration{
+						{FunctionName: aws.String("function-a")},
+					},
+					NextMarker: aws.String("marker"),
+				},
+			},
+			err:                 errors.New("AccessDeniedException"),
+			expectedDisplayName: []string{"function-a"},
+			expectedError:       "AccessDeniedException",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			var calls int
+			conn := lambda.New(lambda.Options{
+				Region:      endpoints.UsWest2RegionID,
+				Credentials: aws.AnonymousCredentials{},
+				APIOptions: []func(*middleware.Stack) error{
+					func(stack *middleware.Stack) error {
+						return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stub", func(_ context.Context, _ middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							calls++
+							if calls <= len(testCase.pages) {
+								return middleware.InitializeOutput{Result: testCase.pages[calls-1]}, middleware.Metadata{}, nil
+							}
+							return middleware.InitializeOutput{}, middleware.Metadata{}, testCase.err
+						}), middleware.Before)
+					},
+				},
+			})
+
+			results := testListFunctions(ctx, t, conn)
+
+			var displayNames []string
+			for i, result := range results {
+				if result.Diagnostics.HasError() {
+					if i != len(results)-1 {
+						t.Errorf("result %d: error is not the last result", i)
+					}
+					if testCase.expectedError == "" {
+						t.Errorf("result %d: unexpected error: %v", i, result.Diagnostics)
+					} else if got := result.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, testCase.expectedError) {
+						t.Errorf("result %d: error %q does not contain %q", i, got, testCase.expectedError)
+					}
+					continue
+				}
+				displayNames = append(displayNames, result.DisplayName)
+			}
+			if testCase.expectedError != "" && !results[len(results)-1].Diagnostics.HasError() {
+				t.Errorf("expected error %q, got none", testCase.expectedError)
+			}
+			if diff := cmp.Diff(displayNames, testCase.expectedDisplayName); diff != "" {
+				t.Errorf("unexpected display names (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func testListFunctions(ctx context.Context, t *testing.T, conn *lambda.Client) []list.ListResult {
+	t.Helper()
+
+	awsClient := &conns.AWSClient{}
+	conns.SetAWSConfig(awsClient, &aws.Config{Region: endpoints.UsWest2RegionID})
+	conns.SetAPIClient(awsClient, endpoints.UsWest2RegionID, names.Lambda, conn)
+
+	identitySpec := inttypes.RegionalSingleParameterIdentity("function_name")
+	r := resourceFunction()
+	r.Identity = &sdkschema.ResourceIdentity{
+		SchemaFunc: func() map[string]*sdkschema.Schema {
+			return identity.NewIdentitySchema(identitySpec)
+		},
+	}
+
+	l := &functionListResource{}
+	l.SetResourceSchema(r)
+	l.SetIdentitySpec(identitySpec)
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: awsClient}, &resource.ConfigureResponse{})
+
+	request := list.ListRequest{
+		ResourceSchema:         schema.Schema{},
+		ResourceIdentitySchema: identityschema.Schema{},
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, request, &stream)
+
+	return slices.Collect(stream.Results)
+}
@@ -131,7 +131,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity("function_name"),
 		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
@@ -178,6 +179,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*inttypes.ServicePackageSDKListResource {
+	return []*inttypes.ServicePackageSDKListResource{
+		{
+			Factory:  functionResourceAsListResource,
+			TypeName: "aws_lambda_function",
+			Name:     "Function",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity("function_name"),
+		},
+	}
+}
+
 func (p *servicePackage) ServicePackageName() string {
 	return names.Lambda
 }
@@ -9,49 +9,49 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
 		Name: "aws_lightsail_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
 		Name: "aws_lightsail_disk",
 		F:    sweepDisks,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
 		Name: "aws_lightsail_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
 		Name: "aws_lightsail_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
 		Name: "aws_lightsail_lb",
 		F:    sweepLoadBalancers,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -276,7 +276,7 @@ func sweepInstances(region string) error {
 	conn := client.LightsailClient(ctx)
 
 	input := &lightsail.GetInstancesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.GetInstances(ctx, input)
@@ -291,19 +291,12 @@ func sweepInstances(region string) error {
 		}
 
 		for _, instance := range output.Instances {
-			name := aws.ToString(instance.Name)
-			input := &lightsail.DeleteInstanceInput{
-				InstanceName: instance.Name,
-			}
-
-			log.Printf("[INFO] Deleting Lightsail Instance: %s", name)
-			_, err := conn.DeleteInstance(ctx, input)
+			r := ResourceInstance()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(instance.Name))
+			d.Set(names.AttrARN, instance.Arn)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Lightsail Instance (%s): %s", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.ToString(output.NextPageToken) == "" {
@@ -313,7 +306,13 @@ func sweepInstances(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lightsail Instances (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepLoadBalancers(region string) error {
@@ -368,6 +367,7 @@ func sweepStaticIPs(region string) error {
 	conn := client.LightsailClient(ctx)
 
 	input := &lightsail.GetStaticIpsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.GetStaticIps(ctx, input)
@@ -379,21 +379,16 @@ func sweepStaticIPs(region string) error {
 			return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
 		}
 
-		if len(output.StaticIps) == 0 {
-			log.Print("[DEBUG] No Lightsail Static IPs to sweep")
-			return nil
-		}
-
 		for _, staticIp := range output.StaticIps {
 			name := aws.ToString(staticIp.Name)
 
-			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
-			_, err := conn.ReleaseStaticIp(ctx, &lightsail.ReleaseStaticIpInput{
-				StaticIpName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
-			}
+			r := ResourceStaticIP()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set(names.AttrName, name)
+			d.Set(names.AttrARN, staticIp.Arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if output.NextPageToken == nil {
@@ -402,5 +397,11 @@ func sweepStaticIPs(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lightsail Static IPs (%s): %w", region, err)
+	}
+
 	return nil
 }
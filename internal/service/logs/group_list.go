@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_log_group")
+func logGroupResourceAsListResource() inttypes.ListResourceForSDKTypeName {
+	return &logGroupListResource{}
+}
+
+type logGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *logGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LogsClient(ctx)
+
+	tflog.Info(ctx, "Listing CloudWatch Logs Log Groups")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input cloudwatchlogs.DescribeLogGroupsInput
+		for item, err := range listLogGroups(ctx, conn, &input) {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Listing CloudWatch Logs Log Groups", err.Error())
+				yield(result)
+				return
+			}
+
+			name := aws.ToString(item.LogGroupName)
+			ctx := tflog.SetField(ctx, "tf_aws.cloudwatch_log_group.name", name)
+
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set(names.AttrName, name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, d, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listLogGroups(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeLogGroupsInput) iter.Seq2[awstypes.LogGroup, error] {
+	return func(yield func(awstypes.LogGroup, error) bool) {
+		pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.LogGroup{}, fmt.Errorf("listing CloudWatch Logs Log Groups: %w", err))
+				return
+			}
+
+			for _, item := range page.LogGroups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -137,7 +137,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName),
 		},
 		{
 			Factory:  resourceMetricFilter,
@@ -172,6 +173,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*inttypes.ServicePackageSDKListResource {
+	return []*inttypes.ServicePackageSDKListResource{
+		{
+			Factory:  logGroupResourceAsListResource,
+			TypeName: "aws_cloudwatch_log_group",
+			Name:     "Log Group",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName),
+		},
+	}
+}
+
 func (p *servicePackage) ServicePackageName() string {
 	return names.Logs
 }
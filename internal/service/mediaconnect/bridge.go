@@ -0,0 +1,904 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_bridge", name="Bridge")
+// @ArnIdentity(identityDuplicateAttributes="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Bridge")
+func newBridgeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bridgeResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultUpdateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type bridgeResource struct {
+	framework.ResourceWithModel[bridgeResourceModel]
+	framework.WithImportByARN
+	framework.WithTimeouts
+}
+
+func (r *bridgeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bridge_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BridgeState](),
+				Computed:   true,
+			},
+			"desired_state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.DesiredStateActive, awstypes.DesiredStateStandby)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrInstanceID: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_bitrate": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrInstanceID: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_bitrate": schema.Int32Attribute{
+							Required: true,
+						},
+						"max_outputs": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"network_output": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkOutputModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIPAddress: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int32Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+									"ttl": schema.Int32Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"flow_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeFlowSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("flow_source"),
+									path.MatchRelative().AtParent().AtName("network_source"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flow_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"output_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"flow_vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+								},
+							},
+						},
+						"network_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multicast_ip": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int32Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_failover_config": failoverConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *bridgeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mediaconnect.CreateBridgeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateBridge(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Bridge (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Bridge.BridgeArn)
+	data.BridgeARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	bridge, err := waitBridgeCreated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if v := awstypes.DesiredState(data.DesiredState.ValueString()); v != "" && v != bridgeDesiredState(bridge.BridgeState) {
+		if bridge, err = updateBridgeState(ctx, conn, arn, v, timeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) state", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, bridge)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bridgeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findBridgeByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bridgeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := new.ID.ValueString()
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.EgressGatewayBridge.Equal(old.EgressGatewayBridge) ||
+		!new.IngressGatewayBridge.Equal(old.IngressGatewayBridge) ||
+		!new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := mediaconnect.UpdateBridgeInput{
+			BridgeArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if new.SourceFailoverConfig.IsNull() && !old.SourceFailoverConfig.IsNull() {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				State: awstypes.StateDisabled,
+			}
+		}
+
+		_, err := conn.UpdateBridge(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	oldSources, diags := old.Sources.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	newSources, diags := new.Sources.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	oldOutputs, diags := old.Outputs.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	newOutputs, diags := new.Outputs.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	addSources, removeSources, updateSources := diffByName(oldSources, newSources, (*bridgeSourceModel).name)
+	addOutputs, removeOutputs, updateOutputs := diffByName(oldOutputs, newOutputs, (*bridgeOutputModel).name)
+
+	if len(addSources) > 0 {
+		input := mediaconnect.AddBridgeSourcesInput{
+			BridgeArn: aws.String(arn),
+		}
+		for _, v := range addSources {
+			var apiObject awstypes.AddBridgeSourceRequest
+			response.Diagnostics.Append(fwflex.Expand(ctx, v, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			input.Sources = append(input.Sources, apiObject)
+		}
+
+		_, err := conn.AddBridgeSources(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Bridge (%s) sources", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range updateSources {
+		input := mediaconnect.UpdateBridgeSourceInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[1], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.BridgeArn = aws.String(arn)
+		input.SourceName = aws.String(v[1].name())
+
+		_, err := conn.UpdateBridgeSource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) source (%s)", arn, v[1].name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range removeSources {
+		input := mediaconnect.RemoveBridgeSourceInput{
+			BridgeArn:  aws.String(arn),
+			SourceName: aws.String(v.name()),
+		}
+
+		_, err := conn.RemoveBridgeSource(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Bridge (%s) source (%s)", arn, v.name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range removeOutputs {
+		input := mediaconnect.RemoveBridgeOutputInput{
+			BridgeArn:  aws.String(arn),
+			OutputName: aws.String(v.name()),
+		}
+
+		_, err := conn.RemoveBridgeOutput(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Bridge (%s) output (%s)", arn, v.name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range updateOutputs {
+		input := mediaconnect.UpdateBridgeOutputInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[1], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.BridgeArn = aws.String(arn)
+		input.OutputName = aws.String(v[1].name())
+
+		_, err := conn.UpdateBridgeOutput(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) output (%s)", arn, v[1].name()), err.Error())
+
+			return
+		}
+	}
+
+	if len(addOutputs) > 0 {
+		input := mediaconnect.AddBridgeOutputsInput{
+			BridgeArn: aws.String(arn),
+		}
+		for _, v := range addOutputs {
+			var apiObject awstypes.AddBridgeOutputRequest
+			response.Diagnostics.Append(fwflex.Expand(ctx, v, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			input.Outputs = append(input.Outputs, apiObject)
+		}
+
+		_, err := conn.AddBridgeOutputs(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Bridge (%s) outputs", arn), err.Error())
+
+			return
+		}
+	}
+
+	bridge, err := waitBridgeUpdated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) update", arn), err.Error())
+
+		return
+	}
+
+	if v := awstypes.DesiredState(new.DesiredState.ValueString()); v != "" && v != bridgeDesiredState(bridge.BridgeState) {
+		if bridge, err = updateBridgeState(ctx, conn, arn, v, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Bridge (%s) state", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(new.flatten(ctx, bridge)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bridgeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bridgeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A bridge must be stopped before it can be deleted.
+	bridge, err := findBridgeByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	if bridgeDesiredState(bridge.BridgeState) == awstypes.DesiredStateActive {
+		if _, err := updateBridgeState(ctx, conn, arn, awstypes.DesiredStateStandby, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Bridge (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	input := mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	_, err = conn.DeleteBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Bridge (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, arn, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Bridge (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func updateBridgeState(ctx context.Context, conn *mediaconnect.Client, arn string, state awstypes.DesiredState, timeout time.Duration) (*awstypes.Bridge, error) {
+	input := mediaconnect.UpdateBridgeStateInput{
+		BridgeArn:    aws.String(arn),
+		DesiredState: state,
+	}
+
+	if _, err := conn.UpdateBridgeState(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	if state == awstypes.DesiredStateActive {
+		return waitBridgeActive(ctx, conn, arn, timeout)
+	}
+
+	return waitBridgeStandby(ctx, conn, arn, timeout)
+}
+
+// bridgeDesiredState returns the desired state that a bridge state results from.
+func bridgeDesiredState(state awstypes.BridgeState) awstypes.DesiredState {
+	switch state {
+	case awstypes.BridgeStateActive, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting, awstypes.BridgeStateStartPending:
+		return awstypes.DesiredStateActive
+	default:
+		return awstypes.DesiredStateStandby
+	}
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+
+	output, err := findBridge(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.BridgeState; state == awstypes.BridgeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findBridge(ctx context.Context, conn *mediaconnect.Client, input *mediaconnect.DescribeBridgeInput) (*awstypes.Bridge, error) {
+	output, err := conn.DescribeBridge(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateCreating, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting, awstypes.BridgeStateStartPending),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		tfresource.SetLastError(err, messageDetailsError(output.BridgeMessages))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeploying, awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStandby),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		tfresource.SetLastError(err, messageDetailsError(output.BridgeMessages))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeActive(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeploying, awstypes.BridgeStateStandby, awstypes.BridgeStateStarting, awstypes.BridgeStateStartPending),
+		Target:  enum.Slice(awstypes.BridgeStateActive),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		tfresource.SetLastError(err, messageDetailsError(output.BridgeMessages))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeStandby(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateActive, awstypes.BridgeStateStopping),
+		Target:  enum.Slice(awstypes.BridgeStateStandby),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		tfresource.SetLastError(err, messageDetailsError(output.BridgeMessages))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeleting, awstypes.BridgeStateStandby),
+		Target:  []string{},
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		tfresource.SetLastError(err, messageDetailsError(output.BridgeMessages))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type bridgeResourceModel struct {
+	framework.WithRegionModel
+	BridgeARN            types.String                                               `tfsdk:"arn"`
+	BridgeState          fwtypes.StringEnum[awstypes.BridgeState]                   `tfsdk:"bridge_state"`
+	DesiredState         types.String                                               `tfsdk:"desired_state"`
+	EgressGatewayBridge  fwtypes.ListNestedObjectValueOf[egressGatewayBridgeModel]  `tfsdk:"egress_gateway_bridge"`
+	ID                   types.String                                               `tfsdk:"id"`
+	IngressGatewayBridge fwtypes.ListNestedObjectValueOf[ingressGatewayBridgeModel] `tfsdk:"ingress_gateway_bridge"`
+	Name                 types.String                                               `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[bridgeOutputModel]         `tfsdk:"output"`
+	PlacementARN         fwtypes.ARN                                                `tfsdk:"placement_arn"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]       `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[bridgeSourceModel]         `tfsdk:"source"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+// flatten sets the model from the bridge, keeping sources and outputs in their configured order.
+func (m *bridgeResourceModel) flatten(ctx context.Context, bridge *awstypes.Bridge) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sources, d := m.Sources.ToSlice(ctx)
+	diags.Append(d...)
+	outputs, d := m.Outputs.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sortByName(bridge.Sources, bridgeSourceName, tfslices.ApplyToAll(sources, (*bridgeSourceModel).name))
+	sortByName(bridge.Outputs, bridgeOutputName, tfslices.ApplyToAll(outputs, (*bridgeOutputModel).name))
+
+	diags.Append(fwflex.Flatten(ctx, bridge, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.DesiredState = types.StringValue(string(bridgeDesiredState(bridge.BridgeState)))
+
+	return diags
+}
+
+func bridgeSourceName(apiObject awstypes.BridgeSource) string {
+	switch {
+	case apiObject.FlowSource != nil:
+		return aws.ToString(apiObject.FlowSource.Name)
+	case apiObject.NetworkSource != nil:
+		return aws.ToString(apiObject.NetworkSource.Name)
+	}
+
+	return ""
+}
+
+func bridgeOutputName(apiObject awstypes.BridgeOutput) string {
+	switch {
+	case apiObject.FlowOutput != nil:
+		return aws.ToString(apiObject.FlowOutput.Name)
+	case apiObject.NetworkOutput != nil:
+		return aws.ToString(apiObject.NetworkOutput.Name)
+	}
+
+	return ""
+}
+
+type egressGatewayBridgeModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	MaxBitrate types.Int32  `tfsdk:"max_bitrate"`
+}
+
+type ingressGatewayBridgeModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	MaxBitrate types.Int32  `tfsdk:"max_bitrate"`
+	MaxOutputs types.Int32  `tfsdk:"max_outputs"`
+}
+
+type bridgeOutputModel struct {
+	NetworkOutput fwtypes.ListNestedObjectValueOf[bridgeNetworkOutputModel] `tfsdk:"network_output"`
+}
+
+func (m *bridgeOutputModel) name() string {
+	if v, diags := m.NetworkOutput.ToPtr(context.Background()); !diags.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeNetworkOutputModel struct {
+	IPAddress   types.String                          `tfsdk:"ip_address"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int32                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+	TTL         types.Int32                           `tfsdk:"ttl"`
+}
+
+type bridgeSourceModel struct {
+	FlowSource    fwtypes.ListNestedObjectValueOf[bridgeFlowSourceModel]    `tfsdk:"flow_source"`
+	NetworkSource fwtypes.ListNestedObjectValueOf[bridgeNetworkSourceModel] `tfsdk:"network_source"`
+}
+
+func (m *bridgeSourceModel) name() string {
+	ctx := context.Background()
+
+	if v, diags := m.FlowSource.ToPtr(ctx); !diags.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+	if v, diags := m.NetworkSource.ToPtr(ctx); !diags.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeFlowSourceModel struct {
+	FlowARN                    fwtypes.ARN                                                  `tfsdk:"flow_arn"`
+	FlowVPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"flow_vpc_interface_attachment"`
+	Name                       types.String                                                 `tfsdk:"name"`
+	OutputARN                  types.String                                                 `tfsdk:"output_arn"`
+}
+
+type bridgeNetworkSourceModel struct {
+	MulticastIP types.String                          `tfsdk:"multicast_ip"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int32                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectBridge_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Bridge
+	resourceName := "aws_mediaconnect_bridge.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediaconnect", regexache.MustCompile(`bridge:.+:`+rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "bridge_state"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.0.max_bitrate", "10000000"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.0.max_outputs", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_arn", "aws_mediaconnect_gateway.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.name", "source1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectBridge_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Bridge
+	resourceName := "aws_mediaconnect_bridge.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceBridge, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBridgeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_bridge" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Bridge %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBridgeExists(ctx context.Context, n string, v *awstypes.Bridge) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBridgeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_gateway" "test" {
+  name               = %[1]q
+  egress_cidr_blocks = ["10.0.0.0/16"]
+
+  network {
+    name       = "network1"
+    cidr_block = "10.0.1.0/24"
+  }
+}
+`, rName)
+}
+
+func testAccBridgeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBridgeConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_bridge" "test" {
+  name          = %[1]q
+  placement_arn = aws_mediaconnect_gateway.test.arn
+
+  ingress_gateway_bridge {
+    max_bitrate = 10000000
+    max_outputs = 2
+  }
+
+  source {
+    network_source {
+      name         = "source1"
+      multicast_ip = "224.0.0.1"
+      network_name = "network1"
+      port         = 5000
+      protocol     = "rtp"
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func messageDetailsError(apiObjects []awstypes.MessageDetail) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, messageDetailError(apiObject))
+	}
+
+	return errors.Join(errs...)
+}
+
+func messageDetailError(apiObject awstypes.MessageDetail) error {
+	return errs.APIError(aws.ToString(apiObject.Code), aws.ToString(apiObject.Message))
+}
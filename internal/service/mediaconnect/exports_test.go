@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+// Exports for use in tests only.
+var (
+	ResourceBridge          = newBridgeResource
+	ResourceFlow            = newFlowResource
+	ResourceFlowEntitlement = newFlowEntitlementResource
+	ResourceGateway         = newGatewayResource
+
+	FindBridgeByARN                 = findBridgeByARN
+	FindFlowByARN                   = findFlowByARN
+	FindFlowEntitlementByTwoPartKey = findFlowEntitlementByTwoPartKey
+	FindGatewayByARN                = findGatewayByARN
+)
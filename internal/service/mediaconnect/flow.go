@@ -0,0 +1,1403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity(identityDuplicateAttributes="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Flow")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultUpdateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithModel[flowResourceModel]
+	framework.WithImportByARN
+	framework.WithTimeouts
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"desired_state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(awstypes.DesiredStateStandby)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.DesiredStateActive, awstypes.DesiredStateStandby)...),
+				},
+			},
+			"egress_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[maintenanceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"maintenance_day": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MaintenanceDay](),
+							Required:   true,
+						},
+						"maintenance_deadline": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"maintenance_start_hour": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"media_stream": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"clock_rate": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"fmt": schema.Int32Attribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"media_stream_id": schema.Int32Attribute{
+							Required: true,
+						},
+						"media_stream_name": schema.StringAttribute{
+							Required: true,
+						},
+						"media_stream_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MediaStreamType](),
+							Required:   true,
+						},
+						"video_format": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"cidr_allow_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDestination: schema.StringAttribute{
+							Optional: true,
+						},
+						"max_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"min_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"output_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputStatus](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrPort: schema.Int32Attribute{
+							Optional: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Required:   true,
+						},
+						"remote_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"smoothing_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption":               encryptionBlock(ctx),
+						"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"ingest_ip": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ingest_port": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_bitrate": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"min_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Optional:   true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_interface_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"whitelist_cidr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"decryption": encryptionBlock(ctx),
+					},
+				},
+			},
+			"source_failover_config": failoverConfigBlock(ctx),
+			"vpc_interface": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"network_interface_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"network_interface_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func failoverConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[failoverConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"failover_mode": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FailoverMode](),
+					Optional:   true,
+					Computed:   true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"recovery_window": schema.Int32Attribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Int32{
+						int32planmodifier.UseStateForUnknown(),
+					},
+				},
+				names.AttrState: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.State](),
+					Optional:   true,
+					Computed:   true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"source_priority": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[sourcePriorityModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"primary_source": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func encryptionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Algorithm](),
+					Optional:   true,
+				},
+				"constant_initialization_vector": schema.StringAttribute{
+					Optional: true,
+				},
+				"device_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"key_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeyType](),
+					Optional:   true,
+					Computed:   true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				names.AttrRegion: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrResourceID: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"secret_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Optional:   true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func vpcInterfaceAttachmentBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"vpc_interface_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mediaconnect.CreateFlowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Flow.FlowArn)
+	data.FlowARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	if _, err := waitFlowCreated(ctx, conn, arn, timeout); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if err := createTags(ctx, conn, arn, getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting MediaConnect Flow (%s) tags", arn), err.Error())
+
+		return
+	}
+
+	if awstypes.DesiredState(data.DesiredState.ValueString()) == awstypes.DesiredStateActive {
+		if err := startFlow(ctx, conn, arn, timeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("starting MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, flow)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	switch output.Status {
+	case awstypes.StatusActive, awstypes.StatusStarting:
+		data.DesiredState = types.StringValue(string(awstypes.DesiredStateActive))
+	default:
+		data.DesiredState = types.StringValue(string(awstypes.DesiredStateStandby))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := new.ID.ValueString()
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.Maintenance.Equal(old.Maintenance) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if new.SourceFailoverConfig.IsNull() && !old.SourceFailoverConfig.IsNull() {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				State: awstypes.StateDisabled,
+			}
+		}
+
+		_, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	// VPC interfaces and media streams are added before, and removed after, the sources and outputs that refer to them.
+	oldVPCInterfaces, diags := old.VPCInterfaces.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	newVPCInterfaces, diags := new.VPCInterfaces.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	oldMediaStreams, diags := old.MediaStreams.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	newMediaStreams, diags := new.MediaStreams.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	oldSources, diags := old.Sources.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	newSources, diags := new.Sources.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	oldOutputs, diags := old.Outputs.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	newOutputs, diags := new.Outputs.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// VPC interfaces can't be modified, so changed interfaces are removed and re-added.
+	addVPCInterfaces, removeVPCInterfaces, updateVPCInterfaces := diffByName(oldVPCInterfaces, newVPCInterfaces, (*vpcInterfaceModel).name)
+	for _, v := range updateVPCInterfaces {
+		removeVPCInterfaces = append(removeVPCInterfaces, v[0])
+		addVPCInterfaces = append(addVPCInterfaces, v[1])
+	}
+	addMediaStreams, removeMediaStreams, updateMediaStreams := diffByName(oldMediaStreams, newMediaStreams, (*mediaStreamModel).name)
+	addSources, removeSources, updateSources := diffByName(oldSources, newSources, (*sourceModel).name)
+	addOutputs, removeOutputs, updateOutputs := diffByName(oldOutputs, newOutputs, (*outputModel).name)
+
+	for _, v := range removeVPCInterfaces {
+		// Interfaces that are being re-added must be removed before they can be added.
+		if !slices.ContainsFunc(addVPCInterfaces, func(a *vpcInterfaceModel) bool { return a.name() == v.name() }) {
+			continue
+		}
+
+		response.Diagnostics.Append(removeFlowVPCInterface(ctx, conn, arn, v, timeout)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if len(addVPCInterfaces) > 0 {
+		input := mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn: aws.String(arn),
+		}
+		for _, v := range addVPCInterfaces {
+			var apiObject awstypes.VpcInterfaceRequest
+			response.Diagnostics.Append(fwflex.Expand(ctx, v, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			input.VpcInterfaces = append(input.VpcInterfaces, apiObject)
+		}
+
+		_, err := conn.AddFlowVpcInterfaces(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) VPC interfaces", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	if len(addMediaStreams) > 0 {
+		input := mediaconnect.AddFlowMediaStreamsInput{
+			FlowArn: aws.String(arn),
+		}
+		for _, v := range addMediaStreams {
+			var apiObject awstypes.AddMediaStreamRequest
+			response.Diagnostics.Append(fwflex.Expand(ctx, v, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			input.MediaStreams = append(input.MediaStreams, apiObject)
+		}
+
+		_, err := conn.AddFlowMediaStreams(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) media streams", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range updateMediaStreams {
+		input := mediaconnect.UpdateFlowMediaStreamInput{
+			FlowArn: aws.String(arn),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[1], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateFlowMediaStream(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) media stream (%s)", arn, v[1].name()), err.Error())
+
+			return
+		}
+	}
+
+	if len(addSources) > 0 {
+		input := mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+		}
+		for _, v := range addSources {
+			var apiObject awstypes.SetSourceRequest
+			response.Diagnostics.Append(fwflex.Expand(ctx, v, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			input.Sources = append(input.Sources, apiObject)
+		}
+
+		_, err := conn.AddFlowSources(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) sources", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range updateSources {
+		input := mediaconnect.UpdateFlowSourceInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[1], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.FlowArn = aws.String(arn)
+		input.SourceArn = v[0].SourceARN.ValueStringPointer()
+
+		_, err := conn.UpdateFlowSource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) source (%s)", arn, v[1].name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range removeSources {
+		input := mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: v.SourceARN.ValueStringPointer(),
+		}
+
+		_, err := conn.RemoveFlowSource(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) source (%s)", arn, v.name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range removeOutputs {
+		input := mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(arn),
+			OutputArn: v.OutputARN.ValueStringPointer(),
+		}
+
+		_, err := conn.RemoveFlowOutput(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) output (%s)", arn, v.name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range updateOutputs {
+		input := mediaconnect.UpdateFlowOutputInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, v[1], &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.FlowArn = aws.String(arn)
+		input.OutputArn = v[0].OutputARN.ValueStringPointer()
+
+		_, err := conn.UpdateFlowOutput(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) output (%s)", arn, v[1].name()), err.Error())
+
+			return
+		}
+	}
+
+	if len(addOutputs) > 0 {
+		input := mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(arn),
+		}
+		for _, v := range addOutputs {
+			var apiObject awstypes.AddOutputRequest
+			response.Diagnostics.Append(fwflex.Expand(ctx, v, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			input.Outputs = append(input.Outputs, apiObject)
+		}
+
+		_, err := conn.AddFlowOutputs(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) outputs", arn), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range removeMediaStreams {
+		input := mediaconnect.RemoveFlowMediaStreamInput{
+			FlowArn:         aws.String(arn),
+			MediaStreamName: v.MediaStreamName.ValueStringPointer(),
+		}
+
+		_, err := conn.RemoveFlowMediaStream(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) media stream (%s)", arn, v.name()), err.Error())
+
+			return
+		}
+	}
+
+	for _, v := range removeVPCInterfaces {
+		if slices.ContainsFunc(addVPCInterfaces, func(a *vpcInterfaceModel) bool { return a.name() == v.name() }) {
+			continue
+		}
+
+		response.Diagnostics.Append(removeFlowVPCInterface(ctx, conn, arn, v, timeout)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+		return
+	}
+
+	if !new.DesiredState.Equal(old.DesiredState) {
+		switch awstypes.DesiredState(new.DesiredState.ValueString()) {
+		case awstypes.DesiredStateActive:
+			if err := startFlow(ctx, conn, arn, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("starting MediaConnect Flow (%s)", arn), err.Error())
+
+				return
+			}
+		case awstypes.DesiredStateStandby:
+			if err := stopFlow(ctx, conn, arn, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Flow (%s)", arn), err.Error())
+
+				return
+			}
+		}
+	}
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(new.flatten(ctx, flow)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A flow must be stopped before it can be deleted.
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	switch flow.Status {
+	case awstypes.StatusActive, awstypes.StatusStarting:
+		if err := stopFlow(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	input := mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err = conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, arn, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func removeFlowVPCInterface(ctx context.Context, conn *mediaconnect.Client, arn string, v *vpcInterfaceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := mediaconnect.RemoveFlowVpcInterfaceInput{
+		FlowArn:          aws.String(arn),
+		VpcInterfaceName: v.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.RemoveFlowVpcInterface(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) VPC interface (%s)", arn, v.name()), err.Error())
+
+		return diags
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+		diags.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	input := mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	if _, err := conn.StartFlow(ctx, &input); err != nil {
+		return err
+	}
+
+	if _, err := waitFlowActive(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for start: %w", err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	input := mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	if _, err := conn.StopFlow(ctx, &input); err != nil {
+		return err
+	}
+
+	if _, err := waitFlowStandby(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for stop: %w", err)
+	}
+
+	return nil
+}
+
+// diffByName returns the elements of new that aren't in old, the elements of old that aren't in new
+// and the [old, new] pairs of elements with the same name that differ.
+func diffByName[T any](old, new []*T, name func(*T) string) ([]*T, []*T, [][2]*T) {
+	var add, remove []*T
+	var update [][2]*T
+
+	for _, n := range new {
+		i := slices.IndexFunc(old, func(o *T) bool { return name(o) == name(n) })
+		if i == -1 {
+			add = append(add, n)
+			continue
+		}
+
+		if o := old[i]; !reflect.DeepEqual(o, n) {
+			update = append(update, [2]*T{o, n})
+		}
+	}
+
+	for _, o := range old {
+		if !slices.ContainsFunc(new, func(n *T) bool { return name(o) == name(n) }) {
+			remove = append(remove, o)
+		}
+	}
+
+	return add, remove, update
+}
+
+// sortByName orders apiObjects to match the order of the names in order.
+// Objects whose name isn't in order are placed last.
+func sortByName[T any](apiObjects []T, name func(T) string, order []string) {
+	index := func(v T) int {
+		if i := slices.Index(order, name(v)); i != -1 {
+			return i
+		}
+		return len(order)
+	}
+
+	slices.SortStableFunc(apiObjects, func(a, b T) int {
+		return index(a) - index(b)
+	})
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	return findFlow(ctx, conn, &input)
+}
+
+func findFlow(ctx context.Context, conn *mediaconnect.Client, input *mediaconnect.DescribeFlowInput) (*awstypes.Flow, error) {
+	output, err := conn.DescribeFlow(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive, awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowActive(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusStandby, awstypes.StatusStarting, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStandby(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStandby),
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	framework.WithRegionModel
+	AvailabilityZone     types.String                                         `tfsdk:"availability_zone"`
+	DesiredState         types.String                                         `tfsdk:"desired_state"`
+	EgressIP             types.String                                         `tfsdk:"egress_ip"`
+	FlowARN              types.String                                         `tfsdk:"arn"`
+	ID                   types.String                                         `tfsdk:"id"`
+	Maintenance          fwtypes.ListNestedObjectValueOf[maintenanceModel]    `tfsdk:"maintenance"`
+	MediaStreams         fwtypes.ListNestedObjectValueOf[mediaStreamModel]    `tfsdk:"media_stream"`
+	Name                 types.String                                         `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[outputModel]         `tfsdk:"output"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel] `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[sourceModel]         `tfsdk:"source"`
+	Status               fwtypes.StringEnum[awstypes.Status]                  `tfsdk:"status"`
+	Tags                 tftags.Map                                           `tfsdk:"tags"`
+	TagsAll              tftags.Map                                           `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                       `tfsdk:"timeouts"`
+	VPCInterfaces        fwtypes.ListNestedObjectValueOf[vpcInterfaceModel]   `tfsdk:"vpc_interface"`
+}
+
+// flatten sets the model from the flow, keeping nested objects in their configured order.
+// Source and output protocol settings are returned in a nested Transport object.
+func (m *flowResourceModel) flatten(ctx context.Context, flow *awstypes.Flow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sources, d := m.Sources.ToSlice(ctx)
+	diags.Append(d...)
+	outputs, d := m.Outputs.ToSlice(ctx)
+	diags.Append(d...)
+	vpcInterfaces, d := m.VPCInterfaces.ToSlice(ctx)
+	diags.Append(d...)
+	mediaStreams, d := m.MediaStreams.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(flow.Sources) == 0 && flow.Source != nil {
+		flow.Sources = []awstypes.Source{*flow.Source}
+	}
+	sortByName(flow.Sources, func(v awstypes.Source) string { return aws.ToString(v.Name) }, tfslices.ApplyToAll(sources, (*sourceModel).name))
+	sortByName(flow.Outputs, func(v awstypes.Output) string { return aws.ToString(v.Name) }, tfslices.ApplyToAll(outputs, (*outputModel).name))
+	sortByName(flow.VpcInterfaces, func(v awstypes.VpcInterface) string { return aws.ToString(v.Name) }, tfslices.ApplyToAll(vpcInterfaces, (*vpcInterfaceModel).name))
+	sortByName(flow.MediaStreams, func(v awstypes.MediaStream) string { return aws.ToString(v.MediaStreamName) }, tfslices.ApplyToAll(mediaStreams, (*mediaStreamModel).name))
+
+	diags.Append(fwflex.Flatten(ctx, flow, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sources, d = m.Sources.ToSlice(ctx)
+	diags.Append(d...)
+	outputs, d = m.Outputs.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, v := range sources {
+		if transport := flow.Sources[i].Transport; transport != nil {
+			diags.Append(fwflex.Flatten(ctx, transport, v)...)
+		}
+	}
+	for i, v := range outputs {
+		if transport := flow.Outputs[i].Transport; transport != nil {
+			diags.Append(fwflex.Flatten(ctx, transport, v)...)
+		} else {
+			v.CIDRAllowList = fwtypes.NewListValueOfNull[types.String](ctx)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	m.Sources = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, sources)
+	m.Outputs = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, outputs)
+
+	return diags
+}
+
+type encryptionModel struct {
+	Algorithm                    fwtypes.StringEnum[awstypes.Algorithm] `tfsdk:"algorithm"`
+	ConstantInitializationVector types.String                           `tfsdk:"constant_initialization_vector"`
+	DeviceID                     types.String                           `tfsdk:"device_id"`
+	KeyType                      fwtypes.StringEnum[awstypes.KeyType]   `tfsdk:"key_type"`
+	Region                       types.String                           `tfsdk:"region"`
+	ResourceID                   types.String                           `tfsdk:"resource_id"`
+	RoleARN                      fwtypes.ARN                            `tfsdk:"role_arn"`
+	SecretARN                    fwtypes.ARN                            `tfsdk:"secret_arn"`
+	URL                          types.String                           `tfsdk:"url"`
+}
+
+type failoverConfigModel struct {
+	FailoverMode   fwtypes.StringEnum[awstypes.FailoverMode]            `tfsdk:"failover_mode"`
+	RecoveryWindow types.Int32                                          `tfsdk:"recovery_window"`
+	SourcePriority fwtypes.ListNestedObjectValueOf[sourcePriorityModel] `tfsdk:"source_priority"`
+	State          fwtypes.StringEnum[awstypes.State]                   `tfsdk:"state"`
+}
+
+type maintenanceModel struct {
+	MaintenanceDay       fwtypes.StringEnum[awstypes.MaintenanceDay] `tfsdk:"maintenance_day"`
+	MaintenanceDeadline  types.String                                `tfsdk:"maintenance_deadline"`
+	MaintenanceStartHour types.String                                `tfsdk:"maintenance_start_hour"`
+}
+
+type mediaStreamModel struct {
+	ClockRate       types.Int32                                  `tfsdk:"clock_rate"`
+	Description     types.String                                 `tfsdk:"description"`
+	Fmt             types.Int32                                  `tfsdk:"fmt"`
+	MediaStreamID   types.Int32                                  `tfsdk:"media_stream_id"`
+	MediaStreamName types.String                                 `tfsdk:"media_stream_name"`
+	MediaStreamType fwtypes.StringEnum[awstypes.MediaStreamType] `tfsdk:"media_stream_type"`
+	VideoFormat     types.String                                 `tfsdk:"video_format"`
+}
+
+func (m *mediaStreamModel) name() string {
+	return m.MediaStreamName.ValueString()
+}
+
+type outputModel struct {
+	CIDRAllowList          fwtypes.ListOfString                                         `tfsdk:"cidr_allow_list"`
+	Description            types.String                                                 `tfsdk:"description"`
+	Destination            types.String                                                 `tfsdk:"destination"`
+	Encryption             fwtypes.ListNestedObjectValueOf[encryptionModel]             `tfsdk:"encryption"`
+	MaxLatency             types.Int32                                                  `tfsdk:"max_latency"`
+	MinLatency             types.Int32                                                  `tfsdk:"min_latency"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	OutputARN              types.String                                                 `tfsdk:"arn"`
+	OutputStatus           fwtypes.StringEnum[awstypes.OutputStatus]                    `tfsdk:"output_status"`
+	Port                   types.Int32                                                  `tfsdk:"port"`
+	Protocol               fwtypes.StringEnum[awstypes.Protocol]                        `tfsdk:"protocol"`
+	RemoteID               types.String                                                 `tfsdk:"remote_id"`
+	SmoothingLatency       types.Int32                                                  `tfsdk:"smoothing_latency"`
+	StreamID               types.String                                                 `tfsdk:"stream_id"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+func (m *outputModel) name() string {
+	return m.Name.ValueString()
+}
+
+type sourceModel struct {
+	Decryption       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"decryption"`
+	Description      types.String                                     `tfsdk:"description"`
+	EntitlementARN   fwtypes.ARN                                      `tfsdk:"entitlement_arn"`
+	IngestIP         types.String                                     `tfsdk:"ingest_ip"`
+	IngestPort       types.Int32                                      `tfsdk:"ingest_port"`
+	MaxBitrate       types.Int32                                      `tfsdk:"max_bitrate"`
+	MaxLatency       types.Int32                                      `tfsdk:"max_latency"`
+	MinLatency       types.Int32                                      `tfsdk:"min_latency"`
+	Name             types.String                                     `tfsdk:"name"`
+	Protocol         fwtypes.StringEnum[awstypes.Protocol]            `tfsdk:"protocol"`
+	SourceARN        types.String                                     `tfsdk:"arn"`
+	StreamID         types.String                                     `tfsdk:"stream_id"`
+	VPCInterfaceName types.String                                     `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR    types.String                                     `tfsdk:"whitelist_cidr"`
+}
+
+func (m *sourceModel) name() string {
+	return m.Name.ValueString()
+}
+
+type sourcePriorityModel struct {
+	PrimarySource types.String `tfsdk:"primary_source"`
+}
+
+type vpcInterfaceModel struct {
+	Name                 types.String                                      `tfsdk:"name"`
+	NetworkInterfaceIDs  fwtypes.ListOfString                              `tfsdk:"network_interface_ids"`
+	NetworkInterfaceType fwtypes.StringEnum[awstypes.NetworkInterfaceType] `tfsdk:"network_interface_type"`
+	RoleARN              fwtypes.ARN                                       `tfsdk:"role_arn"`
+	SecurityGroupIDs     fwtypes.SetOfString                               `tfsdk:"security_group_ids"`
+	SubnetID             types.String                                      `tfsdk:"subnet_id"`
+}
+
+func (m *vpcInterfaceModel) name() string {
+	return m.Name.ValueString()
+}
+
+type vpcInterfaceAttachmentModel struct {
+	VPCInterfaceName types.String `tfsdk:"vpc_interface_name"`
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow_entitlement", name="Flow Entitlement")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Entitlement")
+func newFlowEntitlementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowEntitlementResource{}
+
+	return r, nil
+}
+
+type flowEntitlementResource struct {
+	framework.ResourceWithModel[flowEntitlementResourceModel]
+	framework.WithImportByID
+}
+
+func (r *flowEntitlementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"data_transfer_subscriber_fee_percent": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 100),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entitlement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntitlementStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subscribers": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption": encryptionBlock(ctx),
+		},
+	}
+}
+
+func (r *flowEntitlementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowEntitlementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := data.Name.ValueString()
+	var entitlement awstypes.GrantEntitlementRequest
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &entitlement)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediaconnect.GrantFlowEntitlementsInput{
+		Entitlements: []awstypes.GrantEntitlementRequest{entitlement},
+		FlowArn:      fwflex.StringFromFramework(ctx, data.FlowARN),
+	}
+
+	output, err := conn.GrantFlowEntitlements(ctx, &input)
+
+	if err == nil && len(output.Entitlements) == 0 {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow Entitlement (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &output.Entitlements[0], &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow Entitlement (%s)", name), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowEntitlementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowEntitlementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	output, err := findFlowEntitlementByTwoPartKey(ctx, conn, data.FlowARN.ValueString(), data.EntitlementARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow Entitlement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowEntitlementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowEntitlementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	var input mediaconnect.UpdateFlowEntitlementInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateFlowEntitlement(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow Entitlement (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Entitlement, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowEntitlementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowEntitlementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	input := mediaconnect.RevokeFlowEntitlementInput{
+		EntitlementArn: fwflex.StringFromFramework(ctx, data.EntitlementARN),
+		FlowArn:        fwflex.StringFromFramework(ctx, data.FlowARN),
+	}
+	_, err := conn.RevokeFlowEntitlement(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow Entitlement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findFlowEntitlementByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, entitlementARN string) (*awstypes.Entitlement, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(flow.Entitlements, func(v awstypes.Entitlement) bool {
+		return aws.ToString(v.EntitlementArn) == entitlementARN
+	}))
+}
+
+type flowEntitlementResourceModel struct {
+	framework.WithRegionModel
+	DataTransferSubscriberFeePercent types.Int32                                      `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                     `tfsdk:"description"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	EntitlementARN                   types.String                                     `tfsdk:"arn"`
+	EntitlementStatus                fwtypes.StringEnum[awstypes.EntitlementStatus]   `tfsdk:"entitlement_status"`
+	FlowARN                          fwtypes.ARN                                      `tfsdk:"flow_arn"`
+	ID                               types.String                                     `tfsdk:"id"`
+	Name                             types.String                                     `tfsdk:"name"`
+	Subscribers                      fwtypes.ListOfString                             `tfsdk:"subscribers"`
+}
+
+const (
+	flowEntitlementResourceIDPartCount = 2
+)
+
+func (m *flowEntitlementResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), flowEntitlementResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.FlowARN = fwtypes.ARNValue(parts[0])
+	m.EntitlementARN = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *flowEntitlementResourceModel) setID() (string, error) {
+	parts := []string{
+		m.FlowARN.ValueString(),
+		m.EntitlementARN.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, flowEntitlementResourceIDPartCount, false)
+}
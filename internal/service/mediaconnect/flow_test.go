@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectFlow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flow awstypes.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediaconnect", regexache.MustCompile(`flow:.+:`+rName)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrAvailabilityZone),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", string(awstypes.DesiredStateStandby)),
+					resource.TestCheckResourceAttrSet(resourceName, "egress_ip"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", string(awstypes.ProtocolRtp)),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.24.34.0/23"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.ingest_ip"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusStandby)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flow awstypes.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlow, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_desiredState(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flow awstypes.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_desiredState(rName, string(awstypes.DesiredStateActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", string(awstypes.DesiredStateActive)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusActive)),
+				),
+			},
+			{
+				Config: testAccFlowConfig_desiredState(rName, string(awstypes.DesiredStateStandby)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", string(awstypes.DesiredStateStandby)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusStandby)),
+				),
+			},
+			{
+				Config: testAccFlowConfig_desiredState(rName, string(awstypes.DesiredStateActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", string(awstypes.DesiredStateActive)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_outputs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flow awstypes.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_outputs1(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "10.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5000"),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_outputs2(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5001"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.name", "output2"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.destination", "10.0.0.2"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_maintenance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flow awstypes.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_maintenance(rName, string(awstypes.MaintenanceDayMonday), "02:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.maintenance_day", string(awstypes.MaintenanceDayMonday)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.maintenance_start_hour", "02:00"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_maintenance(rName, string(awstypes.MaintenanceDaySunday), "14:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.maintenance_day", string(awstypes.MaintenanceDaySunday)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.maintenance_start_hour", "14:00"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowExists(ctx context.Context, n string, v *awstypes.Flow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_desiredState(rName, desiredState string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name          = %[1]q
+  desired_state = %[2]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName, desiredState)
+}
+
+func testAccFlowConfig_outputs1(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  output {
+    name        = "output1"
+    protocol    = "rtp"
+    destination = "10.0.0.1"
+    port        = 5000
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_outputs2(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  output {
+    name        = "output1"
+    protocol    = "rtp"
+    destination = "10.0.0.1"
+    port        = 5001
+  }
+
+  output {
+    name        = "output2"
+    protocol    = "rtp"
+    destination = "10.0.0.2"
+    port        = 5000
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_maintenance(rName, day, startHour string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  maintenance {
+    maintenance_day        = %[2]q
+    maintenance_start_hour = %[3]q
+  }
+}
+`, rName, day, startHour)
+}
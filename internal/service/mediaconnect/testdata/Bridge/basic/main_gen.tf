@@ -0,0 +1,38 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mediaconnect_bridge" "test" {
+  name          = var.rName
+  placement_arn = aws_mediaconnect_gateway.test.arn
+
+  ingress_gateway_bridge {
+    max_bitrate = 10000000
+    max_outputs = 2
+  }
+
+  source {
+    network_source {
+      name         = "source1"
+      multicast_ip = "224.0.0.1"
+      network_name = "network1"
+      port         = 5000
+      protocol     = "rtp"
+    }
+  }
+}
+
+resource "aws_mediaconnect_gateway" "test" {
+  name               = var.rName
+  egress_cidr_blocks = ["10.0.0.0/16"]
+
+  network {
+    name       = "network1"
+    cidr_block = "10.0.1.0/24"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
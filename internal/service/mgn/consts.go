@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+const (
+	ResNameApplication                      = "Application"
+	ResNameLaunchConfigurationTemplate      = "Launch Configuration Template"
+	ResNameReplicationConfigurationTemplate = "Replication Configuration Template"
+	ResNameSourceServers                    = "Source Servers"
+	ResNameWave                             = "Wave"
+)
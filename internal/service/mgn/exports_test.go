@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                      = newApplicationResource
+	ResourceLaunchConfigurationTemplate      = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceWave                             = newWaveResource
+
+	FindApplicationByID                      = findApplicationByID
+	FindLaunchConfigurationTemplateByID      = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindWaveApplicationIDs                   = findWaveApplicationIDs
+	FindWaveByID                             = findWaveByID
+)
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_mgn_source_servers", name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithModel[sourceServersDataSourceModel]
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_servers": framework.DataSourceComputedListOfObjectAttribute[sourceServerModel](ctx),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sourceServersFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"application_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"is_archived": schema.BoolAttribute{
+							Optional: true,
+						},
+						"life_cycle_states": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringEnumType[awstypes.LifeCycleState](),
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"replication_types": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringEnumType[awstypes.ReplicationType](),
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"source_server_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().MgnClient(ctx)
+
+	var input mgn.DescribeSourceServersInput
+	resp.Diagnostics.Append(flex.Expand(ctx, data, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findSourceServers(ctx, conn, &input)
+
+	if err != nil {
+		create.AddError(&resp.Diagnostics, names.Mgn, create.ErrActionReading, ResNameSourceServers, "", err)
+
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, output, &data.SourceServers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findSourceServers(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := mgn.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type sourceServersDataSourceModel struct {
+	framework.WithRegionModel
+	Filters       fwtypes.ListNestedObjectValueOf[sourceServersFilterModel] `tfsdk:"filter"`
+	SourceServers fwtypes.ListNestedObjectValueOf[sourceServerModel]        `tfsdk:"source_servers"`
+}
+
+type sourceServersFilterModel struct {
+	ApplicationIDs   fwtypes.ListOfString                                              `tfsdk:"application_ids"`
+	IsArchived       types.Bool                                                        `tfsdk:"is_archived"`
+	LifeCycleStates  fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.LifeCycleState]]  `tfsdk:"life_cycle_states"`
+	ReplicationTypes fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.ReplicationType]] `tfsdk:"replication_types"`
+	SourceServerIDs  fwtypes.ListOfString                                              `tfsdk:"source_server_ids"`
+}
+
+type sourceServerModel struct {
+	ApplicationID       types.String                                              `tfsdk:"application_id"`
+	ARN                 types.String                                              `tfsdk:"arn"`
+	DataReplicationInfo fwtypes.ListNestedObjectValueOf[dataReplicationInfoModel] `tfsdk:"data_replication_info"`
+	IsArchived          types.Bool                                                `tfsdk:"is_archived"`
+	LifeCycle           fwtypes.ListNestedObjectValueOf[lifeCycleModel]           `tfsdk:"life_cycle"`
+	ReplicationType     fwtypes.StringEnum[awstypes.ReplicationType]              `tfsdk:"replication_type"`
+	SourceProperties    fwtypes.ListNestedObjectValueOf[sourcePropertiesModel]    `tfsdk:"source_properties"`
+	SourceServerID      types.String                                              `tfsdk:"source_server_id"`
+	UserProvidedID      types.String                                              `tfsdk:"user_provided_id"`
+	VcenterClientID     types.String                                              `tfsdk:"vcenter_client_id"`
+}
+
+type dataReplicationInfoModel struct {
+	DataReplicationState fwtypes.StringEnum[awstypes.DataReplicationState] `tfsdk:"data_replication_state"`
+	ETADateTime          types.String                                      `tfsdk:"eta_date_time"`
+	LagDuration          types.String                                      `tfsdk:"lag_duration"`
+	LastSnapshotDateTime types.String                                      `tfsdk:"last_snapshot_date_time"`
+}
+
+type lifeCycleModel struct {
+	AddedToServiceDateTime     types.String                                `tfsdk:"added_to_service_date_time"`
+	ElapsedReplicationDuration types.String                                `tfsdk:"elapsed_replication_duration"`
+	FirstByteDateTime          types.String                                `tfsdk:"first_byte_date_time"`
+	LastSeenByServiceDateTime  types.String                                `tfsdk:"last_seen_by_service_date_time"`
+	State                      fwtypes.StringEnum[awstypes.LifeCycleState] `tfsdk:"state"`
+}
+
+type sourcePropertiesModel struct {
+	IdentificationHints     fwtypes.ListNestedObjectValueOf[identificationHintsModel] `tfsdk:"identification_hints"`
+	LastUpdatedDateTime     types.String                                              `tfsdk:"last_updated_date_time"`
+	RAMBytes                types.Int64                                               `tfsdk:"ram_bytes"`
+	RecommendedInstanceType types.String                                              `tfsdk:"recommended_instance_type"`
+}
+
+type identificationHintsModel struct {
+	AWSInstanceID types.String `tfsdk:"aws_instance_id"`
+	FQDN          types.String `tfsdk:"fqdn"`
+	Hostname      types.String `tfsdk:"hostname"`
+	VMPath        types.String `tfsdk:"vm_path"`
+	VMWareUUID    types.String `tfsdk:"vm_ware_uuid"`
+}
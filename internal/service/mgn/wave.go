@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_wave", name="Wave")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Wave")
+func newWaveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &waveResource{}
+
+	return r, nil
+}
+
+type waveResource struct {
+	framework.ResourceWithModel[waveResourceModel]
+	framework.WithImportByID
+}
+
+func (r *waveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+var waveFlexOpt = flex.WithFieldNamePrefix(ResNameWave)
+
+func (r *waveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateWaveInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWave(ctx, &input)
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameWave, data.Name.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if applicationIDs := flex.ExpandFrameworkStringValueSet(ctx, data.ApplicationIDs); len(applicationIDs) > 0 {
+		if err := associateApplications(ctx, conn, data.ID.ValueString(), applicationIDs); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameWave, data.ID.ValueString(), err)
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *waveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findWaveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameWave, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	applicationIDs, err := findWaveApplicationIDs(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameWave, data.ID.ValueString(), err)
+
+		return
+	}
+
+	if len(applicationIDs) > 0 || !data.ApplicationIDs.IsNull() {
+		data.ApplicationIDs = flex.FlattenFrameworkStringValueSetOfString(ctx, applicationIDs)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *waveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := &mgn.UpdateWaveInput{}
+		response.Diagnostics.Append(flex.Expand(ctx, new, input, waveFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Clear the description.
+		if new.Description.IsNull() {
+			input.Description = aws.String("")
+		}
+
+		_, err := conn.UpdateWave(ctx, input)
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameWave, new.ID.ValueString(), err)
+
+			return
+		}
+	}
+
+	if !new.ApplicationIDs.Equal(old.ApplicationIDs) {
+		o, n := flex.ExpandFrameworkStringValueSet(ctx, old.ApplicationIDs), flex.ExpandFrameworkStringValueSet(ctx, new.ApplicationIDs)
+		add, del := n.Difference(o), o.Difference(n)
+
+		if len(del) > 0 {
+			if err := disassociateApplications(ctx, conn, new.ID.ValueString(), del); err != nil {
+				create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameWave, new.ID.ValueString(), err)
+
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			if err := associateApplications(ctx, conn, new.ID.ValueString(), add); err != nil {
+				create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameWave, new.ID.ValueString(), err)
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *waveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	tflog.Debug(ctx, "deleting Mgn Wave", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	if applicationIDs := flex.ExpandFrameworkStringValueSet(ctx, data.ApplicationIDs); len(applicationIDs) > 0 {
+		err := disassociateApplications(ctx, conn, data.ID.ValueString(), applicationIDs)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameWave, data.ID.ValueString(), err)
+
+			return
+		}
+	}
+
+	// Waves must be archived before they can be deleted.
+	if err := archiveWave(ctx, conn, data.ID.ValueString()); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameWave, data.ID.ValueString(), err)
+
+		return
+	}
+
+	input := &mgn.DeleteWaveInput{
+		WaveID: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteWave(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameWave, data.ID.ValueString(), err)
+
+		return
+	}
+}
+
+func associateApplications(ctx context.Context, conn *mgn.Client, waveID string, applicationIDs []string) error {
+	input := &mgn.AssociateApplicationsInput{
+		ApplicationIDs: applicationIDs,
+		WaveID:         aws.String(waveID),
+	}
+
+	_, err := conn.AssociateApplications(ctx, input)
+
+	return err
+}
+
+func disassociateApplications(ctx context.Context, conn *mgn.Client, waveID string, applicationIDs []string) error {
+	input := &mgn.DisassociateApplicationsInput{
+		ApplicationIDs: applicationIDs,
+		WaveID:         aws.String(waveID),
+	}
+
+	_, err := conn.DisassociateApplications(ctx, input)
+
+	return err
+}
+
+func archiveWave(ctx context.Context, conn *mgn.Client, id string) error {
+	wave, err := findWaveByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if aws.ToBool(wave.IsArchived) {
+		return nil
+	}
+
+	input := &mgn.ArchiveWaveInput{
+		WaveID: aws.String(id),
+	}
+
+	_, err = conn.ArchiveWave(ctx, input)
+
+	return err
+}
+
+func findWave(ctx context.Context, conn *mgn.Client, input *mgn.ListWavesInput) (*awstypes.Wave, error) {
+	output, err := findWaves(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findWaves(ctx context.Context, conn *mgn.Client, input *mgn.ListWavesInput) ([]awstypes.Wave, error) {
+	var output []awstypes.Wave
+
+	pages := mgn.NewListWavesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findWaveByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Wave, error) {
+	input := &mgn.ListWavesInput{
+		Filters: &awstypes.ListWavesRequestFilters{
+			WaveIDs: []string{id},
+		},
+	}
+
+	return findWave(ctx, conn, input)
+}
+
+func findWaveApplicationIDs(ctx context.Context, conn *mgn.Client, waveID string) ([]string, error) {
+	input := &mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			WaveIDs: []string{waveID},
+		},
+	}
+
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.Application) string {
+		return aws.ToString(v.ApplicationID)
+	}), nil
+}
+
+type waveResourceModel struct {
+	framework.WithRegionModel
+	ApplicationIDs fwtypes.SetOfString `tfsdk:"application_ids"`
+	ARN            types.String        `tfsdk:"arn"`
+	Description    types.String        `tfsdk:"description" autoflex:",omitempty"`
+	ID             types.String        `tfsdk:"id"`
+	Name           types.String        `tfsdk:"name"`
+	Tags           tftags.Map          `tfsdk:"tags"`
+	TagsAll        tftags.Map          `tfsdk:"tags_all"`
+}
@@ -107,6 +107,10 @@ func (as accountSweeper) Delete(ctx context.Context, optFns ...tfresource.Option
 	return nil
 }
 
+func (as accountSweeper) Unwrap() sweep.Sweepable {
+	return as.sweepable
+}
+
 func sweepOrganizationalUnits(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.OrganizationsClient(ctx)
 
@@ -207,3 +211,7 @@ func (ous organizationalUnitSweeper) Delete(ctx context.Context, optFns ...tfres
 	}
 	return nil
 }
+
+func (ous organizationalUnitSweeper) Unwrap() sweep.Sweepable {
+	return ous.sweepable
+}
@@ -0,0 +1,512 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.Cluster")
+func newClusterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type clusterResource struct {
+	framework.ResourceWithModel[clusterResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *clusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"endpoints": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[endpointModel](ctx),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"networking": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkingModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSubnetIDs: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"scheduler": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[schedulerModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SchedulerType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[clusterSlurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_key": schema.ListAttribute{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[slurmAuthKeyModel](ctx),
+							Computed:   true,
+							ElementType: types.ObjectType{
+								AttrTypes: fwtypes.AttributeTypesMust[slurmAuthKeyModel](ctx),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"scale_down_idle_time_in_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.RequiresReplace(),
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"accounting": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accountingModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"default_purge_time_in_days": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.RequiresReplace(),
+											int32planmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrMode: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccountingMode](),
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+						"slurm_custom_settings": slurmCustomSettingsBlock(ctx, listplanmodifier.RequiresReplace()),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func slurmCustomSettingsBlock(ctx context.Context, planModifiers ...planmodifier.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:    fwtypes.NewListNestedObjectTypeOf[slurmCustomSettingModel](ctx),
+		PlanModifiers: planModifiers,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"parameter_name": schema.StringAttribute{
+					Required: true,
+				},
+				"parameter_value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	name := data.Name.ValueString()
+	var input pcs.CreateClusterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClusterName = aws.String(name)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.Cluster.Id)
+	data.ID = types.StringValue(id)
+
+	cluster, err := waitClusterCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	id := data.ID.ValueString()
+	input := pcs.DeleteClusterInput{
+		ClusterIdentifier: aws.String(id),
+	}
+	_, err := conn.DeleteCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func findClusterByID(ctx context.Context, conn *pcs.Client, id string) (*awstypes.Cluster, error) {
+	input := pcs.GetClusterInput{
+		ClusterIdentifier: aws.String(id),
+	}
+
+	return findCluster(ctx, conn, &input)
+}
+
+func findCluster(ctx context.Context, conn *pcs.Client, input *pcs.GetClusterInput) (*awstypes.Cluster, error) {
+	output, err := conn.GetCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+func statusCluster(conn *pcs.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitClusterCreated(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusCreating),
+		Target:  enum.Slice(awstypes.ClusterStatusActive),
+		Refresh: statusCluster(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		retry.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusDeleting),
+		Target:  []string{},
+		Refresh: statusCluster(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		retry.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type clusterResourceModel struct {
+	framework.WithRegionModel
+	ARN                types.String                                                    `tfsdk:"arn"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                  `tfsdk:"endpoints"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	Name               types.String                                                    `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                 `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                               `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	Status             fwtypes.StringEnum[awstypes.ClusterStatus]                      `tfsdk:"status"`
+	Tags               tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll            tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+func (m *clusterResourceModel) flatten(ctx context.Context, cluster *awstypes.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API always returns the Slurm configuration, including its defaults.
+	// Only track it when it has been configured.
+	configured := !m.SlurmConfiguration.IsNull()
+
+	diags.Append(fwflex.Flatten(ctx, cluster, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !configured {
+		m.SlurmConfiguration = fwtypes.NewListNestedObjectValueOfNull[clusterSlurmConfigurationModel](ctx)
+	}
+
+	return diags
+}
+
+type accountingModel struct {
+	DefaultPurgeTimeInDays types.Int32                                 `tfsdk:"default_purge_time_in_days"`
+	Mode                   fwtypes.StringEnum[awstypes.AccountingMode] `tfsdk:"mode"`
+}
+
+type clusterSlurmConfigurationModel struct {
+	Accounting                 fwtypes.ListNestedObjectValueOf[accountingModel]         `tfsdk:"accounting"`
+	AuthKey                    fwtypes.ListNestedObjectValueOf[slurmAuthKeyModel]       `tfsdk:"auth_key"`
+	ScaleDownIdleTimeInSeconds types.Int32                                              `tfsdk:"scale_down_idle_time_in_seconds"`
+	SlurmCustomSettings        fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_settings"`
+}
+
+type endpointModel struct {
+	Port             types.String                              `tfsdk:"port"`
+	PrivateIPAddress types.String                              `tfsdk:"private_ip_address"`
+	PublicIPAddress  types.String                              `tfsdk:"public_ip_address"`
+	Type             fwtypes.StringEnum[awstypes.EndpointType] `tfsdk:"type"`
+}
+
+type networkingModel struct {
+	SecurityGroupIDs fwtypes.SetOfString  `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.ListOfString `tfsdk:"subnet_ids"`
+}
+
+type schedulerModel struct {
+	Type    fwtypes.StringEnum[awstypes.SchedulerType] `tfsdk:"type"`
+	Version types.String                               `tfsdk:"version"`
+}
+
+type slurmAuthKeyModel struct {
+	SecretARN     types.String `tfsdk:"secret_arn"`
+	SecretVersion types.String `tfsdk:"secret_version"`
+}
+
+type slurmCustomSettingModel struct {
+	ParameterName  types.String `tfsdk:"parameter_name"`
+	ParameterValue types.String `tfsdk:"parameter_value"`
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcs "github.com/hashicorp/terraform-provider-aws/internal/service/pcs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCSCluster_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Cluster
+	resourceName := "aws_pcs_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "pcs", regexache.MustCompile(`cluster/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoints.#"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "networking.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "networking.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "networking.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler.0.type", string(awstypes.SchedulerTypeSlurm)),
+					resource.TestCheckResourceAttr(resourceName, "scheduler.0.version", "24.11"),
+					resource.TestCheckResourceAttr(resourceName, "size", string(awstypes.SizeSmall)),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ClusterStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCSCluster_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Cluster
+	resourceName := "aws_pcs_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcs.ResourceCluster, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCSCluster_slurmConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Cluster
+	resourceName := "aws_pcs_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_slurmConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.accounting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.accounting.0.mode", string(awstypes.AccountingModeStandard)),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.accounting.0.default_purge_time_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.auth_key.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "slurm_configuration.0.auth_key.0.secret_arn"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.scale_down_idle_time_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.0.parameter_name", "SelectTypeParameters"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.0.parameter_value", "CR_CPU"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"slurm_configuration",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcs_cluster" {
+				continue
+			}
+
+			_, err := tfpcs.FindClusterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCS Cluster %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterExists(ctx context.Context, n string, v *awstypes.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCSClient(ctx)
+
+		output, err := tfpcs.FindClusterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccClusterConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccClusterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_pcs_cluster" "test" {
+  name = %[1]q
+  size = "SMALL"
+
+  networking {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = [aws_subnet.test[0].id]
+  }
+
+  scheduler {
+    type    = "SLURM"
+    version = "24.11"
+  }
+}
+`, rName))
+}
+
+func testAccClusterConfig_slurmConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_pcs_cluster" "test" {
+  name = %[1]q
+  size = "SMALL"
+
+  networking {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = [aws_subnet.test[0].id]
+  }
+
+  scheduler {
+    type    = "SLURM"
+    version = "24.11"
+  }
+
+  slurm_configuration {
+    scale_down_idle_time_in_seconds = 600
+
+    accounting {
+      mode                       = "STANDARD"
+      default_purge_time_in_days = 30
+    }
+
+    slurm_custom_settings {
+      parameter_name  = "SelectTypeParameters"
+      parameter_value = "CR_CPU"
+    }
+  }
+}
+`, rName))
+}
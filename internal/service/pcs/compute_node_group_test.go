@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcs "github.com/hashicorp/terraform-provider-aws/internal/service/pcs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCSComputeNodeGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ComputeNodeGroup
+	resourceName := "aws_pcs_compute_node_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroupConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComputeNodeGroupExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "pcs", regexache.MustCompile(`cluster/.+/computenodegroup/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "aws_pcs_cluster.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "compute_node_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "custom_launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_launch_template.0.id", "aws_launch_template.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_instance_profile_arn", "aws_iam_instance_profile.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.instance_type", "t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "purchase_option", string(awstypes.PurchaseOptionOndemand)),
+					resource.TestCheckResourceAttr(resourceName, "scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_configuration.0.max_instance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_configuration.0.min_instance_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ComputeNodeGroupStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeNodeGroupConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComputeNodeGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "scaling_configuration.0.max_instance_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPCSComputeNodeGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ComputeNodeGroup
+	resourceName := "aws_pcs_compute_node_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroupConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcs.ResourceComputeNodeGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCSComputeNodeGroup_slurmConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ComputeNodeGroup
+	resourceName := "aws_pcs_compute_node_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroupConfig_slurmConfiguration(rName, "200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComputeNodeGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.0.parameter_name", "Weight"),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.0.parameter_value", "200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"slurm_configuration",
+				},
+			},
+			{
+				Config: testAccComputeNodeGroupConfig_slurmConfiguration(rName, "300"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComputeNodeGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "slurm_configuration.0.slurm_custom_settings.0.parameter_value", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNodeGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcs_compute_node_group" {
+				continue
+			}
+
+			_, err := tfpcs.FindComputeNodeGroupByTwoPartKey(ctx, conn, rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["compute_node_group_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCS Compute Node Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeNodeGroupExists(ctx context.Context, n string, v *awstypes.ComputeNodeGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCSClient(ctx)
+
+		output, err := tfpcs.FindComputeNodeGroupByTwoPartKey(ctx, conn, rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["compute_node_group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComputeNodeGroupConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                   = %[1]q
+  vpc_security_group_ids = [aws_security_group.test.id]
+}
+
+resource "aws_iam_role" "test" {
+  name = "AWSPCS-%[1]s"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "pcs:RegisterComputeNodeGroupInstance"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "AWSPCS-%[1]s"
+  role = aws_iam_role.test.name
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccComputeNodeGroupConfig_basic(rName string, maxInstanceCount int) string {
+	return acctest.ConfigCompose(testAccComputeNodeGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_pcs_compute_node_group" "test" {
+  cluster_id               = aws_pcs_cluster.test.id
+  name                     = %[1]q
+  iam_instance_profile_arn = aws_iam_instance_profile.test.arn
+  subnet_ids               = [aws_subnet.test[0].id]
+
+  custom_launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  instance_config {
+    instance_type = "t3.medium"
+  }
+
+  scaling_configuration {
+    max_instance_count = %[2]d
+    min_instance_count = 0
+  }
+}
+`, rName, maxInstanceCount))
+}
+
+func testAccComputeNodeGroupConfig_slurmConfiguration(rName, weight string) string {
+	return acctest.ConfigCompose(testAccComputeNodeGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_pcs_compute_node_group" "test" {
+  cluster_id               = aws_pcs_cluster.test.id
+  name                     = %[1]q
+  iam_instance_profile_arn = aws_iam_instance_profile.test.arn
+  subnet_ids               = [aws_subnet.test[0].id]
+
+  custom_launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  instance_config {
+    instance_type = "t3.medium"
+  }
+
+  scaling_configuration {
+    max_instance_count = 1
+    min_instance_count = 0
+  }
+
+  slurm_configuration {
+    slurm_custom_settings {
+      parameter_name  = "Weight"
+      parameter_value = %[2]q
+    }
+  }
+}
+`, rName, weight))
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func errorInfosError(apiObjects []awstypes.ErrorInfo) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, errorInfoError(apiObject))
+	}
+
+	return errors.Join(errs...)
+}
+
+func errorInfoError(apiObject awstypes.ErrorInfo) error {
+	return errs.APIError(aws.ToString(apiObject.Code), aws.ToString(apiObject.Message))
+}
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.Queue")
+func newQueueResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &queueResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type queueResource struct {
+	framework.ResourceWithModel[queueResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *queueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"queue_id": framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QueueStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"compute_node_group_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_node_group_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *queueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, name := data.ClusterID.ValueString(), data.Name.ValueString()
+	var input pcs.CreateQueueInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClusterIdentifier = aws.String(clusterID)
+	input.QueueName = aws.String(name)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateQueue(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Queue (%s)", name), err.Error())
+
+		return
+	}
+
+	data.QueueID = fwflex.StringToFramework(ctx, output.Queue.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Queue (%s)", name), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	queue, err := waitQueueCreated(ctx, conn, clusterID, data.QueueID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, queue)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *queueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	output, err := findQueueByTwoPartKey(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *queueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	if !new.ComputeNodeGroupConfigurations.Equal(old.ComputeNodeGroupConfigurations) {
+		clusterID, queueID := new.ClusterID.ValueString(), new.QueueID.ValueString()
+		var input pcs.UpdateQueueInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClusterIdentifier = aws.String(clusterID)
+		input.QueueIdentifier = aws.String(queueID)
+
+		_, err := conn.UpdateQueue(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Queue (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitQueueUpdated(ctx, conn, clusterID, queueID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *queueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, queueID := data.ClusterID.ValueString(), data.QueueID.ValueString()
+	input := pcs.DeleteQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(queueID),
+	}
+	_, err := conn.DeleteQueue(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Queue (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitQueueDeleted(ctx, conn, clusterID, queueID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findQueueByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, queueID string) (*awstypes.Queue, error) {
+	input := pcs.GetQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(queueID),
+	}
+
+	return findQueue(ctx, conn, &input)
+}
+
+func findQueue(ctx context.Context, conn *pcs.Client, input *pcs.GetQueueInput) (*awstypes.Queue, error) {
+	output, err := conn.GetQueue(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Queue, nil
+}
+
+func statusQueue(conn *pcs.Client, clusterID, queueID string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findQueueByTwoPartKey(ctx, conn, clusterID, queueID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueueCreated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusCreating),
+		Target:  enum.Slice(awstypes.QueueStatusActive),
+		Refresh: statusQueue(conn, clusterID, queueID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		retry.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueUpdated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusUpdating),
+		Target:  enum.Slice(awstypes.QueueStatusActive),
+		Refresh: statusQueue(conn, clusterID, queueID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		retry.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueDeleted(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusDeleting),
+		Target:  []string{},
+		Refresh: statusQueue(conn, clusterID, queueID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		retry.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type queueResourceModel struct {
+	framework.WithRegionModel
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configuration"`
+	ID                             types.String                                                        `tfsdk:"id"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	QueueID                        types.String                                                        `tfsdk:"queue_id"`
+	Status                         fwtypes.StringEnum[awstypes.QueueStatus]                            `tfsdk:"status"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                        tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+const (
+	queueResourceIDPartCount = 2
+)
+
+func (m *queueResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), queueResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ClusterID = types.StringValue(parts[0])
+	m.QueueID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *queueResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ClusterID.ValueString(),
+		m.QueueID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, queueResourceIDPartCount, false)
+}
+
+func (m *queueResourceModel) flatten(ctx context.Context, queue *awstypes.Queue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id := m.ID
+
+	diags.Append(fwflex.Flatten(ctx, queue, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = id
+	m.QueueID = fwflex.StringToFramework(ctx, queue.Id)
+
+	return diags
+}
+
+type computeNodeGroupConfigurationModel struct {
+	ComputeNodeGroupID types.String `tfsdk:"compute_node_group_id"`
+}
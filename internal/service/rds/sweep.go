@@ -457,6 +457,10 @@ func (s instanceAutomatedBackupSweeper) Delete(ctx context.Context, optFns ...tf
 	return nil
 }
 
+func (s instanceAutomatedBackupSweeper) Unwrap() sweep.Sweepable {
+	return s.sweepable
+}
+
 func sweepShardGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RDSClient(ctx)
 	var input rds.DescribeDBShardGroupsInput
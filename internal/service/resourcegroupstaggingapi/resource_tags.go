@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	resourceTagsImportIDSeparator = ","
+)
+
+// @FrameworkResource("aws_resourcegroupstaggingapi_resource_tags", name="Resource Tags")
+func newResourceTagsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTagsResource{}
+
+	return r, nil
+}
+
+type resourceTagsResource struct {
+	framework.ResourceWithModel[resourceTagsResourceModel]
+}
+
+func (r *resourceTagsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTagsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+	if err := tftags.UpdateResourceTags(ctx, conn, names.ResourceGroupsTaggingAPI, arns, nil, tags); err != nil {
+		response.Diagnostics.AddError("creating Resource Groups Tagging API Resource Tags", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceTagsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	output, err := tftags.ListResourceTags(ctx, conn, arns)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Groups Tagging API Resource Tags", err.Error())
+
+		return
+	}
+
+	// Only tags that are set to the same value on all the resources are managed.
+	// Any missing or different tag is reapplied to all the resources on the next update.
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+	if data.Tags.IsNull() {
+		// Imported.
+		tags = output[arns[0]].IgnoreAWS().Map()
+	}
+	for k, v := range tags {
+		for _, arn := range arns {
+			if got := output[arn].KeyValue(k); got == nil || *got != v {
+				delete(tags, k)
+				break
+			}
+		}
+	}
+	data.Tags = fwflex.FlattenFrameworkStringValueMap(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTagsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	newARNs, oldARNs := fwflex.ExpandFrameworkStringValueSet(ctx, new.ResourceARNs), fwflex.ExpandFrameworkStringValueSet(ctx, old.ResourceARNs)
+	newTags, oldTags := fwflex.ExpandFrameworkStringValueMap(ctx, new.Tags), fwflex.ExpandFrameworkStringValueMap(ctx, old.Tags)
+
+	// Untag the resources that are no longer managed.
+	if arns := oldARNs.Difference(newARNs); len(arns) > 0 {
+		if err := tftags.UpdateResourceTags(ctx, conn, names.ResourceGroupsTaggingAPI, arns, oldTags, nil); err != nil {
+			response.Diagnostics.AddError("updating Resource Groups Tagging API Resource Tags", err.Error())
+
+			return
+		}
+	}
+
+	// Tag the newly managed resources.
+	if arns := newARNs.Difference(oldARNs); len(arns) > 0 {
+		if err := tftags.UpdateResourceTags(ctx, conn, names.ResourceGroupsTaggingAPI, arns, nil, newTags); err != nil {
+			response.Diagnostics.AddError("updating Resource Groups Tagging API Resource Tags", err.Error())
+
+			return
+		}
+	}
+
+	// Apply tag changes to the resources that remain managed.
+	if arns := newARNs.Difference(newARNs.Difference(oldARNs)); len(arns) > 0 {
+		if err := tftags.UpdateResourceTags(ctx, conn, names.ResourceGroupsTaggingAPI, arns, oldTags, newTags); err != nil {
+			response.Diagnostics.AddError("updating Resource Groups Tagging API Resource Tags", err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTagsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+	if err := tftags.UpdateResourceTags(ctx, conn, names.ResourceGroupsTaggingAPI, arns, tags, nil); err != nil {
+		response.Diagnostics.AddError("deleting Resource Groups Tagging API Resource Tags", err.Error())
+
+		return
+	}
+}
+
+// The resource has no identity as its only identifying attribute, resource_arns, can be updated in place.
+// It is imported using its comma-separated resource ARNs.
+func (r *resourceTagsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	arns := strings.Split(request.ID, resourceTagsImportIDSeparator)
+	for _, v := range arns {
+		if !arn.IsARN(v) {
+			response.Diagnostics.AddError("importing Resource Groups Tagging API Resource Tags", fmt.Sprintf("unexpected format for ID (%[1]s), expected ARN%[2]sARN%[2]s...", request.ID, resourceTagsImportIDSeparator))
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("resource_arns"), arns)...)
+}
+
+type resourceTagsResourceModel struct {
+	framework.WithRegionModel
+	ResourceARNs fwtypes.SetOfARN `tfsdk:"resource_arns"`
+	Tags         types.Map        `tfsdk:"tags"`
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx, acctest.CtKey1),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 2, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccResourceTagsImportStateIDFunc(resourceName),
+				ImportStateCheck: acctest.ComposeAggregateImportStateCheckFunc(
+					acctest.ImportCheckResourceAttr("resource_arns.#", "2"),
+					acctest.ImportCheckResourceAttr(acctest.CtTagsPercent, "1"),
+					acctest.ImportCheckResourceAttr(acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx, acctest.CtKey1, acctest.CtKey2),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 1, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 3, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagsDestroy(ctx context.Context, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue" {
+				continue
+			}
+
+			output, err := tftags.ListResourceTags(ctx, conn, []string{rs.Primary.Attributes[names.AttrARN]})
+
+			if err != nil {
+				return err
+			}
+
+			for _, key := range keys {
+				if output[rs.Primary.Attributes[names.AttrARN]].KeyExists(key) {
+					return fmt.Errorf("Resource Groups Tagging API Resource Tags (%s) still exists: %s", rs.Primary.Attributes[names.AttrARN], key)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		var arns []string
+		tags := make(map[string]string)
+		for k, v := range rs.Primary.Attributes {
+			if k == "resource_arns.#" || k == acctest.CtTagsPercent {
+				continue
+			}
+			if strings.HasPrefix(k, "resource_arns.") {
+				arns = append(arns, v)
+			}
+			if k, ok := strings.CutPrefix(k, "tags."); ok {
+				tags[k] = v
+			}
+		}
+
+		output, err := tftags.ListResourceTags(ctx, conn, arns)
+
+		if err != nil {
+			return err
+		}
+
+		for _, arn := range arns {
+			for k, v := range tags {
+				if got := output[arn].KeyValue(k); got == nil || *got != v {
+					return fmt.Errorf("Resource Groups Tagging API Resource Tags (%s) not found: %s", arn, k)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceTagsImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		var arns []string
+		for k, v := range rs.Primary.Attributes {
+			if k != "resource_arns.#" && strings.HasPrefix(k, "resource_arns.") {
+				arns = append(arns, v)
+			}
+		}
+
+		return strings.Join(arns, ","), nil
+	}
+}
+
+func testAccResourceTagsConfig_basic(rName string, count int, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resourcegroupstaggingapi_resource_tags" "test" {
+  resource_arns = aws_sqs_queue.test[*].arn
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, count, key, value)
+}
@@ -867,8 +867,13 @@ func recordParseResourceID(id string) [4]string {
 	return [4]string{recZone, recName, recType, recSet}
 }
 
+// recordReadsBeforeZoneListing is the number of a hosted zone's records that are read with their own AWS API calls before the hosted zone's record sets are all listed.
+const recordReadsBeforeZoneListing = 5
+
 func findResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Client, zoneID, recordName, recordType, recordSetID string) (*awstypes.ResourceRecordSet, *string, error) {
-	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+	zone, err := conns.CachedRead(ctx, names.Route53, "GetHostedZone", zoneID, func(ctx context.Context) (*route53.GetHostedZoneOutput, error) {
+		return findHostedZoneByID(ctx, conn, zoneID)
+	})
 
 	if err != nil {
 		return nil, nil, err
@@ -877,17 +882,7 @@ func findResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Clien
 	name := expandRecordName(recordName, aws.ToString(zone.HostedZone.Name))
 	recordName = fqdn(name)
 	rrType := awstypes.RRType(strings.ToUpper(recordType))
-	input := &route53.ListResourceRecordSetsInput{
-		HostedZoneId:    aws.String(zoneID),
-		StartRecordName: aws.String(recordName),
-		StartRecordType: rrType,
-	}
-	if recordSetID == "" {
-		input.MaxItems = aws.Int32(1)
-	} else {
-		input.MaxItems = aws.Int32(100)
-	}
-	output, err := findResourceRecordSet(ctx, conn, input, resourceRecordsFor(recordName, rrType), func(v *awstypes.ResourceRecordSet) bool {
+	filter := func(v *awstypes.ResourceRecordSet) bool {
 		if recordName != strings.ToLower(aws.ToString(v.Name)) {
 			return false
 		}
@@ -899,7 +894,37 @@ func findResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Clien
 		}
 
 		return true
-	})
+	}
+
+	// Once several of a hosted zone's records have been read, one listing of all its record sets serves the reads of the rest.
+	// Listing a large hosted zone takes many calls, so a hosted zone with few managed records is not listed.
+	if conns.ReadCount(ctx, names.Route53, "ListResourceRecordSets", zoneID) > recordReadsBeforeZoneListing {
+		recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		output, err := tfresource.AssertSingleValueResult(tfslices.Filter(recordSets, tfslices.PredicateValue(filter)))
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return output, &name, nil
+	}
+
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(recordName),
+		StartRecordType: rrType,
+	}
+	if recordSetID == "" {
+		input.MaxItems = aws.Int32(1)
+	} else {
+		input.MaxItems = aws.Int32(100)
+	}
+	output, err := findResourceRecordSet(ctx, conn, input, resourceRecordsFor(recordName, rrType), filter)
 
 	if err != nil {
 		return nil, nil, err
@@ -908,6 +933,16 @@ func findResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Clien
 	return output, &name, nil
 }
 
+func findResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Client, zoneID string) ([]awstypes.ResourceRecordSet, error) {
+	return conns.CachedRead(ctx, names.Route53, "ListResourceRecordSets", zoneID, func(ctx context.Context) ([]awstypes.ResourceRecordSet, error) {
+		input := &route53.ListResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		return findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+	})
+}
+
 func findResourceRecordSet(ctx context.Context, conn *route53.Client, input *route53.ListResourceRecordSetsInput, morePages tfslices.Predicate[*route53.ListResourceRecordSetsOutput], filter tfslices.Predicate[*awstypes.ResourceRecordSet]) (*awstypes.ResourceRecordSet, error) {
 	output, err := findResourceRecordSets(ctx, conn, input, morePages, filter)
 
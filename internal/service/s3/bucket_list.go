@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket")
+func bucketResourceAsListResource() inttypes.ListResourceForSDKTypeName {
+	return &bucketListResource{}
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.S3Client(ctx)
+
+	tflog.Info(ctx, "Listing S3 Buckets")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// Only list general purpose buckets in the configured Region.
+		input := s3.ListBucketsInput{
+			BucketRegion: aws.String(awsClient.Region(ctx)),
+		}
+		for item, err := range listBuckets(ctx, conn, &input) {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Listing S3 Buckets", err.Error())
+				yield(result)
+				return
+			}
+
+			bucketName := aws.ToString(item.Name)
+			ctx := tflog.SetField(ctx, logKeyBucketName, bucketName)
+
+			d := l.ResourceData()
+			d.SetId(bucketName)
+			d.Set(names.AttrBucket, bucketName)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = bucketName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, d, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listBuckets(ctx context.Context, conn *s3.Client, input *s3.ListBucketsInput) iter.Seq2[awstypes.Bucket, error] {
+	return func(yield func(awstypes.Bucket, error) bool) {
+		pages := s3.NewListBucketsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Bucket{}, fmt.Errorf("listing S3 Buckets: %w", err))
+				return
+			}
+
+			for _, item := range page.Buckets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
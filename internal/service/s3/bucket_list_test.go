@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBucketListResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pages               []*s3.ListBucketsOutput
+		err                 error
+		expectedDisplayName []string
+		expectedError       string
+	}{
+		"no buckets": {
+			pages: []*s3.ListBucketsOutput{{}},
+		},
+		"display names": {
+			pages: []*s3.ListBucketsOutput{
+				{
+					Buckets: []awstypes.Bucket{
+						{Name: aws.String("bucket-a")},
+						{Name: aws.String("bucket-b")},
+					},
+					ContinuationToken: aws.String("token"),
+				},
+				{
+					Buckets: []awstypes.Bucket{
+						{Name: aws.String("bucket-c")},
+					},
+				},
+			},
+			expectedDisplayName: []string{"bucket-a", "bucket-b", "bucket-c"},
+		},
+		"error after first page": {
+			pages: []*s3.ListBucketsOutput{
+				{
+					Buckets: []awstypes.Bucket{
+						{Name: aws.String("bucket-a")},
+					},
+					ContinuationToken: aws.String("token"),
+				},
+			},
+			err:                 errors.New("AccessDenied"),
+			expectedDisplayName: []string{"bucket-a"},
+			expectedError:       "AccessDenied",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			var inputs []*s3.ListBucketsInput
+			conn := s3.New(s3.Options{
+				Region:      endpoints.UsWest2RegionID,
+				Credentials: aws.AnonymousCredentials{},
+				APIOptions: []func(*middleware.Stack) error{
+					func(stack *middleware.Stack) error {
+						return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stub", func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							inputs = append(inputs, in.Parameters.(*s3.ListBucketsInput))
+							if n := len(inputs); n <= len(testCase.pages) {
+								return middleware.InitializeOutput{Result: testCase.pages[n-1]}, middleware.Metadata{}, nil
+							}
+							return middleware.InitializeOutput{}, middleware.Metadata{}, testCase.err
+						}), middleware.Before)
+					},
+				},
+			})
+
+			results := testListBuckets(ctx, t, conn)
+
+			// Only general purpose buckets in the configured Region are listed.
+			if got, want := aws.ToString(inputs[0].BucketRegion), endpoints.UsWest2RegionID; got != want {
+				t.Errorf("BucketRegion = %q, want %q", got, want)
+			}
+
+			var displayNames []string
+			for i, result := range results {
+				if result.Diagnostics.HasError() {
+					if i != len(results)-1 {
+						t.Errorf("result %d: error is not the last result", i)
+					}
+					if testCase.expectedError == "" {
+						t.Errorf("result %d: unexpected error: %v", i, result.Diagnostics)
+					} else if got := result.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, testCase.expectedError) {
+						t.Errorf("result %d: error %q does not contain %q", i, got, testCase.expectedError)
+					}
+					continue
+				}
+				displayNames = append(displayNames, result.DisplayName)
+			}
+			if testCase.expectedError != "" && !results[len(results)-1].Diagnostics.HasError() {
+				t.Errorf("expected error %q, got none", testCase.expectedError)
+			}
+			if diff := cmp.Diff(displayNames, testCase.expectedDisplayName); diff != "" {
+				t.Errorf("unexpected display names (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func testListBuckets(ctx context.Context, t *testing.T, conn *s3.Client) []list.ListResult {
+	t.Helper()
+
+	awsClient := &conns.AWSClient{}
+	conns.SetAWSConfig(awsClient, &aws.Config{Region: endpoints.UsWest2RegionID})
+	conns.SetAPIClient(awsClient, endpoints.UsWest2RegionID, names.S3, conn)
+
+	identitySpec := inttypes.RegionalSingleParameterIdentity(names.AttrBucket)
+	r := resourceBucket()
+	r.Identity = &sdkschema.ResourceIdentity{
+		SchemaFunc: func() map[string]*sdkschema.Schema {
+			return identity.NewIdentitySchema(identitySpec)
+		},
+	}
+
+	l := &bucketListResource{}
+	l.SetResourceSchema(r)
+	l.SetIdentitySpec(identitySpec)
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: awsClient}, &resource.ConfigureResponse{})
+
+	request := list.ListRequest{
+		ResourceSchema:         schema.Schema{},
+		ResourceIdentitySchema: identityschema.Schema{},
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, request, &stream)
+
+	return slices.Collect(stream.Results)
+}
@@ -22,11 +22,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_ses_configuration_set", name="Configuration Set")
+// @Tags(identifierAttribute="arn", resourceGroupsTaggingAPI=true)
+// @Testing(tagsTest=true)
 func resourceConfigurationSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigurationSetCreate,
@@ -92,6 +95,8 @@ func resourceConfigurationSet() *schema.Resource {
 					},
 				},
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -115,6 +120,16 @@ func resourceConfigurationSetCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(configurationSetName)
 
+	// The SES v1 API has no tagging operations, so tags are applied with the Resource Groups Tagging API.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		if tags := inContext.TagsIn.UnwrapOrDefault(); len(tags) > 0 {
+			c := meta.(*conns.AWSClient)
+			if err := c.ResourceTagsBatcher(ctx).UpdateTags(ctx, names.SES, configurationSetARN(ctx, c, d.Id()), nil, tags); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting SES Configuration Set (%s) tags: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input := &ses.PutConfigurationSetDeliveryOptionsInput{
 			ConfigurationSetName: aws.String(configurationSetName),
@@ -195,14 +210,7 @@ func resourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "reading SES Configuration Set (%s): %s", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition(ctx),
-		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region(ctx),
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
-	}.String()
-	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrARN, configurationSetARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	if err := d.Set("delivery_options", flattenDeliveryOptions(output.DeliveryOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting delivery_options: %s", err)
 	}
@@ -328,6 +336,16 @@ func findConfigurationSet(ctx context.Context, conn *ses.Client, input *ses.Desc
 	return output, nil
 }
 
+func configurationSetARN(ctx context.Context, c *conns.AWSClient, name string) string {
+	return arn.ARN{
+		Partition: c.Partition(ctx),
+		Service:   "ses",
+		Region:    c.Region(ctx),
+		AccountID: c.AccountID(ctx),
+		Resource:  fmt.Sprintf("configuration-set/%s", name),
+	}.String()
+}
+
 func expandDeliveryOptions(tfList []any) *awstypes.DeliveryOptions {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
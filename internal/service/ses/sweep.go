@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,30 +12,31 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
@@ -48,35 +50,27 @@ func sweepConfigurationSets(region string) error {
 	}
 	conn := client.SESClient(ctx)
 	input := &ses.ListConfigurationSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListConfigurationSets(ctx, input)
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Configuration Sets: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("retrieving SES Configuration Sets: %w", err)
 		}
 
 		for _, configurationSet := range output.ConfigurationSets {
 			name := aws.ToString(configurationSet.Name)
 
-			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
-			_, err := conn.DeleteConfigurationSet(ctx, &ses.DeleteConfigurationSetInput{
-				ConfigurationSetName: aws.String(name),
-			})
-			if errs.IsA[*awstypes.ConfigurationSetDoesNotExistException](err) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Configuration Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := resourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set(names.AttrARN, configurationSetARN(ctx, client, name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.ToString(output.NextToken) == "" {
@@ -85,7 +79,13 @@ func sweepConfigurationSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SES Configuration Sets (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepIdentities(region, identityType string) error {
@@ -98,39 +98,41 @@ func sweepIdentities(region, identityType string) error {
 	input := &ses.ListIdentitiesInput{
 		IdentityType: awstypes.IdentityType(identityType),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	newResource := resourceEmailIdentity
+	if identityType == string(awstypes.IdentityTypeDomain) {
+		newResource = resourceDomainIdentity
+	}
 
 	paginator := ses.NewListIdentitiesPaginator(conn, input)
 
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
+			return nil
+		}
 		if err != nil {
-			log.Printf("[ERROR] %s", sweeperErrs)
+			return fmt.Errorf("retrieving SES Identities: %w", err)
 		}
 
 		for _, identity := range output.Identities {
-			log.Printf("[INFO] Deleting SES Identity: %s", identity)
-			_, err = conn.DeleteIdentity(ctx, &ses.DeleteIdentityInput{
-				Identity: aws.String(identity),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Identity (%s): %w", identity, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := newResource()
+			d := r.Data(nil)
+			d.SetId(identity)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Identities: %w", err))
+		return fmt.Errorf("sweeping SES Identities (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepReceiptRuleSets(region string) error {
@@ -141,49 +143,40 @@ func sweepReceiptRuleSets(region string) error {
 	}
 	conn := client.SESClient(ctx)
 
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the active receipt rule set to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+	var activeName string
+	active, err := findActiveReceiptRuleSet(ctx, conn)
 	// In some regions, this will return "InvalidAction" with no message
 	if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "InvalidAction") {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
-	if err != nil {
-		return fmt.Errorf("disabling any currently active SES Receipt Rule Set: %w", err)
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("reading active SES Receipt Rule Set: %w", err)
+	}
+	if active != nil {
+		activeName = aws.ToString(active.Name)
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListReceiptRuleSets(ctx, input)
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Receipt Rule Sets: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("retrieving SES Receipt Rule Sets: %w", err)
 		}
 
 		for _, ruleSet := range output.RuleSets {
 			name := aws.ToString(ruleSet.Name)
 
-			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
-			_, err := conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
-				RuleSetName: aws.String(name),
-			})
-			if errs.IsA[*awstypes.RuleSetDoesNotExistException](err) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Receipt Rule Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.WithDescription(receiptRuleSetSweeper{conn: conn, name: name, active: name == activeName}, sweep.ResourceDescription{
+				CreatedAt: aws.ToTime(ruleSet.CreatedTimestamp),
+				ID:        name,
+			}))
 		}
 
 		if aws.ToString(output.NextToken) == "" {
@@ -192,5 +185,42 @@ func sweepReceiptRuleSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SES Receipt Rule Sets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+type receiptRuleSetSweeper struct {
+	conn   *ses.Client
+	name   string
+	active bool
+}
+
+func (rrss receiptRuleSetSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	if rrss.active {
+		// You cannot delete the receipt rule set that is currently active.
+		// Setting the name of the active receipt rule set to null disables all email receiving.
+		log.Printf("[INFO] Disabling active SES Receipt Rule Set: %s", rrss.name)
+		_, err := rrss.conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+		if err != nil {
+			return fmt.Errorf("disabling active SES Receipt Rule Set (%s): %w", rrss.name, err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", rrss.name)
+	_, err := rrss.conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
+		RuleSetName: aws.String(rrss.name),
+	})
+	if errs.IsA[*awstypes.RuleSetDoesNotExistException](err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("deleting SES Receipt Rule Set (%s): %w", rrss.name, err)
+	}
+
+	return nil
 }
@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Lens")
+// @Testing(importIgnore="json_string;version")
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensResource{}
+
+	return r, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithModel[lensResourceModel]
+	framework.WithImportByID
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"json_string": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+var lensFlexOpt = fwflex.WithFieldNamePrefix("Lens")
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.ImportLensInput{
+		JSONString: fwflex.StringFromFramework(ctx, data.JSONString),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.ImportLens(ctx, &input)
+
+	if err == nil && output.Status == awstypes.ImportLensStatusError {
+		err = fmt.Errorf("import status: %s", output.Status)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.LensArn)
+	data.ID = types.StringValue(arn)
+
+	if output.Status == awstypes.ImportLensStatusInProgress {
+		if err := waitLensImported(ctx, conn, arn, data.JSONString.ValueString(), lensImportTimeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) import", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !data.Version.IsNull() {
+		if err := publishLensVersion(ctx, conn, arn, data.Version.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version", arn), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := tfresource.RetryWhenNotFound(ctx, lensPropagationTimeout, func() (any, error) {
+		return findLensByARN(ctx, conn, arn)
+	})
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &data, lensFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, lensFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.ID.ValueString()
+
+	if !new.JSONString.Equal(old.JSONString) {
+		// Importing over an existing lens updates its draft.
+		input := wellarchitected.ImportLensInput{
+			JSONString: fwflex.StringFromFramework(ctx, new.JSONString),
+			LensAlias:  aws.String(arn),
+		}
+
+		output, err := conn.ImportLens(ctx, &input)
+
+		if err == nil && output.Status == awstypes.ImportLensStatusError {
+			err = fmt.Errorf("import status: %s", output.Status)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+
+		if output.Status == awstypes.ImportLensStatusInProgress {
+			if err := waitLensImported(ctx, conn, arn, new.JSONString.ValueString(), lensImportTimeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) import", arn), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if !new.Version.Equal(old.Version) && !new.Version.IsNull() {
+		if err := publishLensVersion(ctx, conn, arn, new.Version.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version", arn), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &new, lensFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.DeleteLensInput{
+		LensAlias:  fwflex.StringFromFramework(ctx, data.ID),
+		LensStatus: awstypes.LensStatusTypeAll,
+	}
+	_, err := conn.DeleteLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+const (
+	lensImportTimeout      = 5 * time.Minute
+	lensPropagationTimeout = 2 * time.Minute
+)
+
+func publishLensVersion(ctx context.Context, conn *wellarchitected.Client, arn, version string) error {
+	input := wellarchitected.CreateLensVersionInput{
+		LensAlias:   aws.String(arn),
+		LensVersion: aws.String(version),
+	}
+
+	// A version can't be published while an import into the lens's draft is in progress.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, lensImportTimeout, func() (any, error) {
+		return conn.CreateLensVersion(ctx, &input)
+	})
+
+	return err
+}
+
+// waitLensImported waits for an asynchronous import of the specified JSON into the lens's draft to finish.
+// Import status is not reported, so the draft is polled until its name and description match those in the JSON.
+// Publishing a version of the lens also waits for any import into its draft to finish.
+func waitLensImported(ctx context.Context, conn *wellarchitected.Client, arn, jsonString string, timeout time.Duration) error {
+	var lens struct {
+		Description string `json:"description"`
+		Name        string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(jsonString), &lens); err != nil {
+		return err
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findLensDraftByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToString(output.LensName) == lens.Name && aws.ToString(output.Description) == lens.Description, nil
+	}, tfresource.WaitOpts{})
+}
+
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Lens, error) {
+	input := wellarchitected.GetLensInput{
+		LensAlias: aws.String(arn),
+	}
+
+	return findLens(ctx, conn, &input)
+}
+
+func findLens(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.GetLensInput) (*awstypes.Lens, error) {
+	output, err := conn.GetLens(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lens == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Lens, nil
+}
+
+func findLensDraftByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.LensSummary, error) {
+	input := wellarchitected.ListLensesInput{
+		LensStatus: awstypes.LensStatusTypeDraft,
+		LensType:   awstypes.LensTypeCustomSelf,
+	}
+
+	return findLensSummary(ctx, conn, &input, func(v *awstypes.LensSummary) bool {
+		return aws.ToString(v.LensArn) == arn
+	})
+}
+
+func findLensSummary(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensesInput, filter tfslices.Predicate[*awstypes.LensSummary]) (*awstypes.LensSummary, error) {
+	output, err := findLensSummaries(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLensSummaries(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensesInput, filter tfslices.Predicate[*awstypes.LensSummary]) ([]awstypes.LensSummary, error) {
+	var output []awstypes.LensSummary
+
+	pages := wellarchitected.NewListLensesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String         `tfsdk:"arn"`
+	Description types.String         `tfsdk:"description"`
+	ID          types.String         `tfsdk:"id"`
+	JSONString  jsontypes.Normalized `tfsdk:"json_string" autoflex:"-"`
+	Name        types.String         `tfsdk:"name"`
+	Owner       types.String         `tfsdk:"owner"`
+	Tags        tftags.Map           `tfsdk:"tags"`
+	TagsAll     tftags.Map           `tfsdk:"tags_all"`
+	Version     types.String         `tfsdk:"version" autoflex:"-"`
+}
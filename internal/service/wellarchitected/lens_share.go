@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens_share", name="Lens Share")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.LensShareSummary")
+func newLensShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensShareResource{}
+
+	return r, nil
+}
+
+type lensShareResource struct {
+	framework.ResourceWithModel[lensShareResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *lensShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"lens_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": framework.IDAttribute(),
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *lensShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensARN := data.LensARN.ValueString()
+	input := wellarchitected.CreateLensShareInput{
+		LensAlias:  aws.String(lensARN),
+		SharedWith: fwflex.StringFromFramework(ctx, data.SharedWith),
+	}
+
+	output, err := conn.CreateLensShare(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Lens (%s) Share", lensARN), err.Error())
+
+		return
+	}
+
+	data.ShareID = fwflex.StringToFramework(ctx, output.ShareId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Lens (%s) Share", lensARN), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensARN, data.ShareID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Share (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Status = fwtypes.StringEnumValue(share.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensShareByTwoPartKey(ctx, conn, data.LensARN.ValueString(), data.ShareID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.DeleteLensShareInput{
+		LensAlias: fwflex.StringFromFramework(ctx, data.LensARN),
+		ShareId:   fwflex.StringFromFramework(ctx, data.ShareID),
+	}
+	_, err := conn.DeleteLensShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// isShareGone returns whether a share can no longer be used by its recipient.
+func isShareGone(status awstypes.ShareStatus) bool {
+	switch status {
+	case awstypes.ShareStatusExpired, awstypes.ShareStatusRejected, awstypes.ShareStatusRevoked:
+		return true
+	default:
+		return false
+	}
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensARN, shareID string) (*awstypes.LensShareSummary, error) {
+	input := wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensARN),
+	}
+
+	output, err := findLensShare(ctx, conn, &input, func(v *awstypes.LensShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if isShareGone(output.Status) {
+		return nil, &retry.NotFoundError{
+			Message: string(output.Status),
+		}
+	}
+
+	return output, nil
+}
+
+func findLensShare(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) (*awstypes.LensShareSummary, error) {
+	output, err := findLensShares(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLensShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) ([]awstypes.LensShareSummary, error) {
+	var output []awstypes.LensShareSummary
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensShareResourceModel struct {
+	framework.WithRegionModel
+	ID         types.String                             `tfsdk:"id"`
+	LensARN    fwtypes.ARN                              `tfsdk:"lens_arn"`
+	ShareID    types.String                             `tfsdk:"share_id"`
+	SharedWith types.String                             `tfsdk:"shared_with"`
+	Status     fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
+
+const (
+	lensShareResourceIDPartCount = 2
+)
+
+func (m *lensShareResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), lensShareResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.LensARN = fwtypes.ARNValue(parts[0])
+	m.ShareID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *lensShareResourceModel) setID() (string, error) {
+	parts := []string{
+		m.LensARN.ValueString(),
+		m.ShareID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, lensShareResourceIDPartCount, false)
+}
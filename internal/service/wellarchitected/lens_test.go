@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedLens_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+	var v awstypes.Lens
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`lens/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"json_string", names.AttrVersion},
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+	var v awstypes.Lens
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceLens, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+	var v awstypes.Lens
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_version(rName, rName, "1.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				Config: testAccLensConfig_version(rName, rName+"-updated", "2.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLensExists(ctx context.Context, n string, v *awstypes.Lens) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindLensByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLensDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Lens %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLensConfig_jsonString(rName, description string) string {
+	return fmt.Sprintf(`
+locals {
+  lens_json = jsonencode({
+    schemaVersion = "2021-11-01"
+    name          = %[1]q
+    description   = %[2]q
+    pillars = [{
+      id   = "pillar1"
+      name = "Pillar 1"
+      questions = [{
+        id    = "question1"
+        title = "Question 1"
+        choices = [{
+          id    = "choice1"
+          title = "Choice 1"
+        }]
+        riskRules = [{
+          condition = "choice1"
+          risk      = "NO_RISK"
+          }, {
+          condition = "default"
+          risk      = "HIGH_RISK"
+        }]
+      }]
+    }]
+  })
+}
+`, rName, description)
+}
+
+func testAccLensConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccLensConfig_jsonString(rName, description), `
+resource "aws_wellarchitected_lens" "test" {
+  json_string = local.lens_json
+}
+`)
+}
+
+func testAccLensConfig_version(rName, description, version string) string {
+	return acctest.ConfigCompose(testAccLensConfig_jsonString(rName, description), fmt.Sprintf(`
+resource "aws_wellarchitected_lens" "test" {
+  json_string = local.lens_json
+  version     = %[1]q
+}
+`, version))
+}
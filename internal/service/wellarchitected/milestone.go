@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_milestone", name="Milestone")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Milestone")
+func newMilestoneResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &milestoneResource{}
+
+	return r, nil
+}
+
+type milestoneResource struct {
+	framework.ResourceWithModel[milestoneResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *milestoneResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"milestone_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"milestone_number": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"recorded_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *milestoneResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data milestoneResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID := data.WorkloadID.ValueString()
+	var input wellarchitected.CreateMilestoneInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateMilestone(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) Milestone", workloadID), err.Error())
+
+		return
+	}
+
+	data.MilestoneNumber = fwflex.Int32ToFramework(ctx, output.MilestoneNumber)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) Milestone", workloadID), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	milestone, err := findMilestoneByTwoPartKey(ctx, conn, workloadID, aws.ToInt32(output.MilestoneNumber))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Milestone (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.RecordedAt = timetypes.NewRFC3339TimePointerValue(milestone.RecordedAt)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *milestoneResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data milestoneResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findMilestoneByTwoPartKey(ctx, conn, data.WorkloadID.ValueString(), data.MilestoneNumber.ValueInt32())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Milestone (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *milestoneResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data milestoneResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Milestones cannot be deleted; they are removed along with their workload.
+	tflog.Debug(ctx, "removing Well-Architected Milestone from state", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+}
+
+func findMilestoneByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, workloadID string, milestoneNumber int32) (*awstypes.Milestone, error) {
+	input := wellarchitected.GetMilestoneInput{
+		MilestoneNumber: aws.Int32(milestoneNumber),
+		WorkloadId:      aws.String(workloadID),
+	}
+
+	return findMilestone(ctx, conn, &input)
+}
+
+func findMilestone(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.GetMilestoneInput) (*awstypes.Milestone, error) {
+	output, err := conn.GetMilestone(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Milestone == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Milestone, nil
+}
+
+type milestoneResourceModel struct {
+	framework.WithRegionModel
+	ID              types.String      `tfsdk:"id"`
+	MilestoneName   types.String      `tfsdk:"milestone_name"`
+	MilestoneNumber types.Int32       `tfsdk:"milestone_number"`
+	RecordedAt      timetypes.RFC3339 `tfsdk:"recorded_at"`
+	WorkloadID      types.String      `tfsdk:"workload_id"`
+}
+
+const (
+	milestoneResourceIDPartCount = 2
+)
+
+func (m *milestoneResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), milestoneResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	milestoneNumber, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return err
+	}
+
+	m.WorkloadID = types.StringValue(parts[0])
+	m.MilestoneNumber = types.Int32Value(int32(milestoneNumber))
+
+	return nil
+}
+
+func (m *milestoneResourceModel) setID() (string, error) {
+	parts := []string{
+		m.WorkloadID.ValueString(),
+		strconv.FormatInt(int64(m.MilestoneNumber.ValueInt32()), 10),
+	}
+
+	return flex.FlattenResourceId(parts, milestoneResourceIDPartCount, false)
+}
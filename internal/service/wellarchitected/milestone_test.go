@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedMilestone_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_milestone.test"
+	workloadResourceName := "aws_wellarchitected_workload.test"
+	var v awstypes.Milestone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Milestones are removed along with their workload.
+		CheckDestroy: testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMilestoneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMilestoneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "milestone_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "milestone_number", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "recorded_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "workload_id", workloadResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMilestoneExists(ctx context.Context, n string, v *awstypes.Milestone) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		milestoneNumber, err := strconv.ParseInt(rs.Primary.Attributes["milestone_number"], 10, 32)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindMilestoneByTwoPartKey(ctx, conn, rs.Primary.Attributes["workload_id"], int32(milestoneNumber))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMilestoneConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkloadConfig_basic(rName), fmt.Sprintf(`
+resource "aws_wellarchitected_milestone" "test" {
+  workload_id    = aws_wellarchitected_workload.test.id
+  milestone_name = %[1]q
+}
+`, rName))
+}
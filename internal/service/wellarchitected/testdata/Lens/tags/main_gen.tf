@@ -0,0 +1,44 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_lens" "test" {
+  json_string = jsonencode({
+    schemaVersion = "2021-11-01"
+    name          = var.rName
+    description   = var.rName
+    pillars = [{
+      id   = "pillar1"
+      name = "Pillar 1"
+      questions = [{
+        id    = "question1"
+        title = "Question 1"
+        choices = [{
+          id    = "choice1"
+          title = "Choice 1"
+        }]
+        riskRules = [{
+          condition = "choice1"
+          risk      = "NO_RISK"
+          }, {
+          condition = "default"
+          risk      = "HIGH_RISK"
+        }]
+      }]
+    }]
+  })
+
+  tags = var.resource_tags
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
@@ -0,0 +1,48 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "null" {}
+
+resource "aws_wellarchitected_lens" "test" {
+  json_string = jsonencode({
+    schemaVersion = "2021-11-01"
+    name          = var.rName
+    description   = var.rName
+    pillars = [{
+      id   = "pillar1"
+      name = "Pillar 1"
+      questions = [{
+        id    = "question1"
+        title = "Question 1"
+        choices = [{
+          id    = "choice1"
+          title = "Choice 1"
+        }]
+        riskRules = [{
+          condition = "choice1"
+          risk      = "NO_RISK"
+          }, {
+          condition = "default"
+          risk      = "HIGH_RISK"
+        }]
+      }]
+    }]
+  })
+
+  tags = {
+    (var.unknownTagKey) = null_resource.test.id
+  }
+}
+
+resource "null_resource" "test" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "unknownTagKey" {
+  type     = string
+  nullable = false
+}
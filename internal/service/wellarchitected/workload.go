@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Workload")
+func newWorkloadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadResource{}
+
+	return r, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithModel[workloadResourceModel]
+	framework.WithImportByID
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"industry": schema.StringAttribute{
+				Optional: true,
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"non_aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"review_owner": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+		},
+	}
+}
+
+var workloadFlexOpt = fwflex.WithFieldNamePrefix("Workload")
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.WorkloadName.ValueString()
+	var input wellarchitected.CreateWorkloadInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, workloadFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkload(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.WorkloadId)
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, workload, &data, workloadFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findWorkloadByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, workloadFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Lenses.Equal(old.Lenses) {
+		oldLenses, newLenses := fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses)
+		add, del := newLenses.Difference(oldLenses), oldLenses.Difference(newLenses)
+
+		if len(add) > 0 {
+			input := wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			_, err := conn.AssociateLenses(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) lenses", id), err.Error())
+
+				return
+			}
+		}
+
+		if len(del) > 0 {
+			input := wellarchitected.DisassociateLensesInput{
+				LensAliases: del,
+				WorkloadId:  aws.String(id),
+			}
+
+			_, err := conn.DisassociateLenses(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) lenses", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if workloadHasChanges(ctx, new, old) {
+		var input wellarchitected.UpdateWorkloadInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, workloadFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Clear removed optional values.
+		if new.AccountIDs.IsNull() {
+			input.AccountIds = []string{}
+		}
+		if new.ArchitecturalDesign.IsNull() {
+			input.ArchitecturalDesign = aws.String("")
+		}
+		if new.AWSRegions.IsNull() {
+			input.AwsRegions = []string{}
+		}
+		if new.Industry.IsNull() {
+			input.Industry = aws.String("")
+		}
+		if new.IndustryType.IsNull() {
+			input.IndustryType = aws.String("")
+		}
+		if new.NonAWSRegions.IsNull() {
+			input.NonAwsRegions = []string{}
+		}
+		if new.Notes.IsNull() {
+			input.Notes = aws.String("")
+		}
+
+		output, err := conn.UpdateWorkload(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload (%s)", id), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		new.PillarPriorities = fwflex.FlattenFrameworkStringValueListOfString(ctx, output.Workload.PillarPriorities)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.DeleteWorkloadInput{
+		WorkloadId: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeleteWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+
+	return findWorkload(ctx, conn, &input)
+}
+
+func findWorkload(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.GetWorkloadInput) (*awstypes.Workload, error) {
+	output, err := conn.GetWorkload(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+type workloadResourceModel struct {
+	framework.WithRegionModel
+	AccountIDs          fwtypes.SetOfString                              `tfsdk:"account_ids"`
+	ArchitecturalDesign types.String                                     `tfsdk:"architectural_design" autoflex:",omitempty"`
+	ARN                 types.String                                     `tfsdk:"arn"`
+	AWSRegions          fwtypes.SetOfString                              `tfsdk:"aws_regions"`
+	Description         types.String                                     `tfsdk:"description"`
+	Environment         fwtypes.StringEnum[awstypes.WorkloadEnvironment] `tfsdk:"environment"`
+	ID                  types.String                                     `tfsdk:"id"`
+	Industry            types.String                                     `tfsdk:"industry" autoflex:",omitempty"`
+	IndustryType        types.String                                     `tfsdk:"industry_type" autoflex:",omitempty"`
+	Lenses              fwtypes.SetOfString                              `tfsdk:"lenses"`
+	NonAWSRegions       fwtypes.SetOfString                              `tfsdk:"non_aws_regions"`
+	Notes               types.String                                     `tfsdk:"notes" autoflex:",omitempty"`
+	Owner               types.String                                     `tfsdk:"owner"`
+	PillarPriorities    fwtypes.ListOfString                             `tfsdk:"pillar_priorities"`
+	ReviewOwner         types.String                                     `tfsdk:"review_owner"`
+	Tags                tftags.Map                                       `tfsdk:"tags"`
+	TagsAll             tftags.Map                                       `tfsdk:"tags_all"`
+	WorkloadName        types.String                                     `tfsdk:"workload_name"`
+}
+
+func workloadHasChanges(_ context.Context, plan, state workloadResourceModel) bool {
+	return !plan.AccountIDs.Equal(state.AccountIDs) ||
+		!plan.ArchitecturalDesign.Equal(state.ArchitecturalDesign) ||
+		!plan.AWSRegions.Equal(state.AWSRegions) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.Environment.Equal(state.Environment) ||
+		!plan.Industry.Equal(state.Industry) ||
+		!plan.IndustryType.Equal(state.IndustryType) ||
+		!plan.NonAWSRegions.Equal(state.NonAWSRegions) ||
+		!plan.Notes.Equal(state.Notes) ||
+		!plan.PillarPriorities.Equal(state.PillarPriorities) ||
+		!plan.ReviewOwner.Equal(state.ReviewOwner) ||
+		!plan.WorkloadName.Equal(state.WorkloadName)
+}
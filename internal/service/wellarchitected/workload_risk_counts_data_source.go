@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_wellarchitected_workload_risk_counts", name="Workload Risk Counts")
+func newWorkloadRiskCountsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workloadRiskCountsDataSource{}, nil
+}
+
+type workloadRiskCountsDataSource struct {
+	framework.DataSourceWithModel[workloadRiskCountsDataSourceModel]
+}
+
+func (d *workloadRiskCountsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"improvement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadImprovementStatus](),
+				Computed:   true,
+			},
+			"milestone_number": schema.Int32Attribute{
+				Optional: true,
+			},
+			"prioritized_risk_counts": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"risk_counts": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *workloadRiskCountsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workloadRiskCountsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WellArchitectedClient(ctx)
+
+	workloadID := data.WorkloadID.ValueString()
+	var workload *awstypes.Workload
+
+	// A milestone records the state of the workload at a point in time.
+	if data.MilestoneNumber.IsNull() {
+		output, err := findWorkloadByID(ctx, conn, workloadID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", workloadID), err.Error())
+
+			return
+		}
+
+		workload = output
+	} else {
+		output, err := findMilestoneByTwoPartKey(ctx, conn, workloadID, data.MilestoneNumber.ValueInt32())
+
+		if err == nil && output.Workload == nil {
+			err = fmt.Errorf("milestone %d has no workload", data.MilestoneNumber.ValueInt32())
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s) Milestone (%d)", workloadID, data.MilestoneNumber.ValueInt32()), err.Error())
+
+			return
+		}
+
+		workload = output.Workload
+	}
+
+	data.ImprovementStatus = fwtypes.StringEnumValue(workload.ImprovementStatus)
+
+	var diags diag.Diagnostics
+	data.PrioritizedRiskCounts, diags = flattenRiskCounts(ctx, workload.PrioritizedRiskCounts)
+	response.Diagnostics.Append(diags...)
+	data.RiskCounts, diags = flattenRiskCounts(ctx, workload.RiskCounts)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenRiskCounts(ctx context.Context, apiObject map[string]int32) (fwtypes.MapValueOf[types.Int64], diag.Diagnostics) {
+	elements := make(map[string]attr.Value, len(apiObject))
+
+	for k, v := range apiObject {
+		elements[k] = types.Int64Value(int64(v))
+	}
+
+	return fwtypes.NewMapValueOf[types.Int64](ctx, elements)
+}
+
+type workloadRiskCountsDataSourceModel struct {
+	framework.WithRegionModel
+	ImprovementStatus     fwtypes.StringEnum[awstypes.WorkloadImprovementStatus] `tfsdk:"improvement_status"`
+	MilestoneNumber       types.Int32                                            `tfsdk:"milestone_number"`
+	PrioritizedRiskCounts fwtypes.MapValueOf[types.Int64]                        `tfsdk:"prioritized_risk_counts"`
+	RiskCounts            fwtypes.MapValueOf[types.Int64]                        `tfsdk:"risk_counts"`
+	WorkloadID            types.String                                           `tfsdk:"workload_id"`
+}
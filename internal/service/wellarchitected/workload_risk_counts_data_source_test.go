@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkloadRiskCountsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_wellarchitected_workload_risk_counts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadRiskCountsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "improvement_status"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "milestone_number"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "risk_counts.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkloadRiskCountsDataSource_milestone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_wellarchitected_workload_risk_counts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadRiskCountsDataSourceConfig_milestone(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "improvement_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "milestone_number", "aws_wellarchitected_milestone.test", "milestone_number"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "risk_counts.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkloadRiskCountsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkloadConfig_basic(rName), `
+data "aws_wellarchitected_workload_risk_counts" "test" {
+  workload_id = aws_wellarchitected_workload.test.id
+}
+`)
+}
+
+func testAccWorkloadRiskCountsDataSourceConfig_milestone(rName string) string {
+	return acctest.ConfigCompose(testAccMilestoneConfig_basic(rName), `
+data "aws_wellarchitected_workload_risk_counts" "test" {
+  workload_id      = aws_wellarchitected_milestone.test.workload_id
+  milestone_number = aws_wellarchitected_milestone.test.milestone_number
+}
+`)
+}
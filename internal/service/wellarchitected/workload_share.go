@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload_share", name="Workload Share")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.WorkloadShareSummary")
+func newWorkloadShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadShareResource{}
+
+	return r, nil
+}
+
+type workloadShareResource struct {
+	framework.ResourceWithModel[workloadShareResourceModel]
+	framework.WithImportByID
+}
+
+func (r *workloadShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"permission_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PermissionType](),
+				Required:   true,
+			},
+			"share_id": framework.IDAttribute(),
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID := data.WorkloadID.ValueString()
+	var input wellarchitected.CreateWorkloadShareInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateWorkloadShare(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) Share", workloadID), err.Error())
+
+		return
+	}
+
+	data.ShareID = fwflex.StringToFramework(ctx, output.ShareId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) Share", workloadID), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	share, err := findWorkloadShareByTwoPartKey(ctx, conn, workloadID, data.ShareID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload Share (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Status = fwtypes.StringEnumValue(share.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findWorkloadShareByTwoPartKey(ctx, conn, data.WorkloadID.ValueString(), data.ShareID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadShareResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new workloadShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	if !new.PermissionType.Equal(old.PermissionType) {
+		var input wellarchitected.UpdateWorkloadShareInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateWorkloadShare(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload Share (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		new.Status = fwtypes.StringEnumValue(output.WorkloadShare.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workloadShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.DeleteWorkloadShareInput{
+		ShareId:    fwflex.StringFromFramework(ctx, data.ShareID),
+		WorkloadId: fwflex.StringFromFramework(ctx, data.WorkloadID),
+	}
+	_, err := conn.DeleteWorkloadShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findWorkloadShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, workloadID, shareID string) (*awstypes.WorkloadShareSummary, error) {
+	input := wellarchitected.ListWorkloadSharesInput{
+		WorkloadId: aws.String(workloadID),
+	}
+
+	output, err := findWorkloadShare(ctx, conn, &input, func(v *awstypes.WorkloadShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if isShareGone(output.Status) {
+		return nil, &retry.NotFoundError{
+			Message: string(output.Status),
+		}
+	}
+
+	return output, nil
+}
+
+func findWorkloadShare(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListWorkloadSharesInput, filter tfslices.Predicate[*awstypes.WorkloadShareSummary]) (*awstypes.WorkloadShareSummary, error) {
+	output, err := findWorkloadShares(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findWorkloadShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListWorkloadSharesInput, filter tfslices.Predicate[*awstypes.WorkloadShareSummary]) ([]awstypes.WorkloadShareSummary, error) {
+	var output []awstypes.WorkloadShareSummary
+
+	pages := wellarchitected.NewListWorkloadSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.WorkloadShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type workloadShareResourceModel struct {
+	framework.WithRegionModel
+	ID             types.String                                `tfsdk:"id"`
+	PermissionType fwtypes.StringEnum[awstypes.PermissionType] `tfsdk:"permission_type"`
+	ShareID        types.String                                `tfsdk:"share_id"`
+	SharedWith     types.String                                `tfsdk:"shared_with"`
+	Status         fwtypes.StringEnum[awstypes.ShareStatus]    `tfsdk:"status"`
+	WorkloadID     types.String                                `tfsdk:"workload_id"`
+}
+
+const (
+	workloadShareResourceIDPartCount = 2
+)
+
+func (m *workloadShareResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), workloadShareResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.WorkloadID = types.StringValue(parts[0])
+	m.ShareID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *workloadShareResourceModel) setID() (string, error) {
+	parts := []string{
+		m.WorkloadID.ValueString(),
+		m.ShareID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, workloadShareResourceIDPartCount, false)
+}
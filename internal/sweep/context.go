@@ -5,11 +5,19 @@ package sweep
 
 import (
 	"context"
+	"runtime"
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type (
+	regionKey       struct{}
+	resourceTypeKey struct{}
+)
+
+// Context returns a Context for sweeping resources in the specified Region.
+// If called from a registered sweeper function, resources are swept as that sweeper's resource type.
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +25,49 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionKey{}, region)
+
+	if resourceType := callerResourceType(sweeperFuncs); resourceType != "" {
+		ctx = WithResourceType(ctx, resourceType)
+	}
+
 	return ctx
 }
+
+// WithResourceType returns a Context for sweeping resources of the specified type.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeKey{}, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey{}).(string)
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeKey{}).(string)
+	return v
+}
+
+// callerResourceType returns the name of the sweeper whose function, as recorded in funcs, called the caller, or "" if there is none.
+// Sweeper functions are passed only a Region, so the resource type is found from the call stack.
+func callerResourceType(funcs map[string]string) string {
+	const maxDepth = 8
+
+	pcs := make([]uintptr, maxDepth)
+	n := runtime.Callers(3, pcs) // Skip runtime.Callers, callerResourceType and its caller.
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if v := funcs[frame.Function]; v != "" {
+			return v
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -104,6 +105,31 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// Describe describes the resource from the attributes that identify it.
+func (sr *sweepResource) Describe(context.Context) filter.Resource {
+	attrs := make(map[string]any, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		attrs[attr.path] = attr.value
+	}
+
+	var id string
+	if v, ok := attrs[names.AttrID].(string); ok {
+		id = v
+	} else {
+		for _, attr := range sr.attributes {
+			if v, ok := attr.value.(string); ok {
+				id = v
+				break
+			}
+		}
+	}
+
+	return filter.NewResource(id, func(name string) (any, bool) {
+		v, ok := attrs[name]
+		return v, ok
+	})
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
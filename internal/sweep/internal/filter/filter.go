@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resource describes a resource that a sweeper would delete.
+// Zero values are unknown.
+type Resource struct {
+	ARN       string
+	CreatedAt time.Time
+	ID        string
+	Tags      map[string]string
+}
+
+// creationTimeAttributes are the names of attributes commonly used for a resource's creation time.
+var creationTimeAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreateTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	"create_date",
+}
+
+// NewResource returns the description of a resource with the specified ID and attribute values.
+// getAttr returns an attribute's value, if it is set.
+func NewResource(id string, getAttr func(name string) (any, bool)) Resource {
+	r := Resource{
+		ID: id,
+	}
+
+	if v, ok := getAttr(names.AttrARN); ok {
+		if v, ok := v.(string); ok {
+			r.ARN = v
+		}
+	}
+
+	// Many resources are identified by their ARN.
+	if r.ARN == "" && arn.IsARN(id) {
+		r.ARN = id
+	}
+
+	if v, ok := getAttr(names.AttrTags); ok {
+		switch v := v.(type) {
+		case map[string]string:
+			r.Tags = v
+		case map[string]any:
+			r.Tags = make(map[string]string, len(v))
+			for k, v := range v {
+				if v, ok := v.(string); ok {
+					r.Tags[k] = v
+				}
+			}
+		}
+	}
+
+	for _, name := range creationTimeAttributes {
+		v, ok := getAttr(name)
+		if !ok {
+			continue
+		}
+
+		switch v := v.(type) {
+		case time.Time:
+			r.CreatedAt = v
+		case string:
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				r.CreatedAt = t
+			}
+		}
+
+		if !r.CreatedAt.IsZero() {
+			break
+		}
+	}
+
+	return r
+}
+
+// Options configures the filtering of the resources that sweepers delete.
+type Options struct {
+	DryRun        bool              // List the resources that would be deleted without deleting them.
+	MinAge        time.Duration     // Only delete resources created at least this long ago.
+	ProtectedARNs map[string]bool   // Never delete resources with these ARNs or IDs.
+	ReportFile    string            // Path of the JSON report of a run.
+	Tags          map[string]string // Only delete resources with these tags. An empty value matches any value.
+}
+
+// OptionsFromEnv returns the filtering options configured by environment variables.
+func OptionsFromEnv() (*Options, error) {
+	opts := &Options{
+		ReportFile: os.Getenv(envvar.SweepReportFile),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.DryRun = b
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		opts.MinAge = d
+	}
+
+	if v := os.Getenv(envvar.SweepProtectedARNsFile); v != "" {
+		arns, err := readProtectedARNs(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepProtectedARNsFile, err)
+		}
+		opts.ProtectedARNs = arns
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		opts.Tags = make(map[string]string)
+		for _, tag := range strings.Split(v, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(tag), "=")
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: empty tag key", envvar.SweepTags)
+			}
+			opts.Tags[key] = value
+		}
+	}
+
+	return opts, nil
+}
+
+// readProtectedARNs reads a file of protected resource ARNs, one per line.
+// Blank lines and lines starting with '#' are ignored.
+func readProtectedARNs(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	arns := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		arns[line] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return arns, nil
+}
+
+// FiltersTags returns whether resources are filtered by tags.
+func (o *Options) FiltersTags() bool {
+	return len(o.Tags) > 0
+}
+
+// Incomplete returns whether the filters need data that is missing from the specified resource's description.
+func (o *Options) Incomplete(r Resource) bool {
+	return (len(o.ProtectedARNs) > 0 && r.ARN == "") ||
+		(o.FiltersTags() && r.Tags == nil && r.ARN == "") || // Tags are looked up by ARN.
+		(o.MinAge > 0 && r.CreatedAt.IsZero())
+}
+
+// Skip returns the reason that the specified resource must not be deleted, or "" if it can be deleted.
+func (o *Options) Skip(r Resource, now time.Time) string {
+	if o.ProtectedARNs[r.ARN] || o.ProtectedARNs[r.ID] {
+		return "protected"
+	}
+
+	// A resource whose ARN is unknown may be protected.
+	if len(o.ProtectedARNs) > 0 && r.ARN == "" && !arn.IsARN(r.ID) {
+		return "ARN unknown"
+	}
+
+	if o.FiltersTags() {
+		if r.Tags == nil {
+			return "tags unknown"
+		}
+
+		for k, v := range o.Tags {
+			if got, ok := r.Tags[k]; !ok || (v != "" && got != v) {
+				return fmt.Sprintf("not tagged %s", k)
+			}
+		}
+	}
+
+	if o.MinAge > 0 {
+		if r.CreatedAt.IsZero() {
+			return "creation time unknown"
+		}
+
+		if age := now.Sub(r.CreatedAt); age < o.MinAge {
+			return fmt.Sprintf("created %s ago", age.Truncate(time.Second))
+		}
+	}
+
+	return ""
+}
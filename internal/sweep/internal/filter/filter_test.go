@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestNewResource(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		id    string
+		attrs map[string]any
+		want  Resource
+	}{
+		"empty": {
+			want: Resource{ID: "id-1"},
+		},
+		"all": {
+			attrs: map[string]any{
+				"arn":        "arn:aws:sqs:us-west-2:123456789012:q", //lintignore:AWSAT003,AWSAT005
+				"created_at": createdAt.Format(time.RFC3339),
+				"tags":       map[string]any{"key1": "value1"},
+			},
+			want: Resource{
+				ARN:       "arn:aws:sqs:us-west-2:123456789012:q", //lintignore:AWSAT003,AWSAT005
+				CreatedAt: createdAt,
+				ID:        "id-1",
+				Tags:      map[string]string{"key1": "value1"},
+			},
+		},
+		"time value": {
+			attrs: map[string]any{
+				"creation_date": createdAt,
+				"tags":          map[string]string{"key1": "value1"},
+			},
+			want: Resource{
+				CreatedAt: createdAt,
+				ID:        "id-1",
+				Tags:      map[string]string{"key1": "value1"},
+			},
+		},
+		"ARN as ID": {
+			id:   "arn:aws:sqs:us-west-2:123456789012:q",                                                            //lintignore:AWSAT003,AWSAT005
+			want: Resource{ARN: "arn:aws:sqs:us-west-2:123456789012:q", ID: "arn:aws:sqs:us-west-2:123456789012:q"}, //lintignore:AWSAT003,AWSAT005
+		},
+		"invalid time": {
+			attrs: map[string]any{
+				"create_time": "yesterday",
+			},
+			want: Resource{ID: "id-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id := testCase.id
+			if id == "" {
+				id = "id-1"
+			}
+
+			got := NewResource(id, func(name string) (any, bool) {
+				v, ok := testCase.attrs[name]
+				return v, ok
+			})
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOptionsSkip(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		options  Options
+		resource Resource
+		want     string
+	}{
+		"no filters": {
+			resource: Resource{ID: "id-1"},
+		},
+		"protected ARN": {
+			options:  Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			resource: Resource{ARN: "arn:aws:s3:::b", ID: "b"},                        //lintignore:AWSAT005
+			want:     "protected",
+		},
+		"protected ID": {
+			options:  Options{ProtectedARNs: map[string]bool{"b": true}},
+			resource: Resource{ID: "b"},
+			want:     "protected",
+		},
+		"ARN unknown": {
+			options:  Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			resource: Resource{ID: "id-1"},
+			want:     "ARN unknown",
+		},
+		"ARN as ID": {
+			options:  Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			resource: Resource{ID: "arn:aws:s3:::c"},                                  //lintignore:AWSAT005
+		},
+		"not protected": {
+			options:  Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			resource: Resource{ARN: "arn:aws:s3:::c", ID: "c"},                        //lintignore:AWSAT005
+		},
+		"tags unknown": {
+			options:  Options{Tags: map[string]string{"tf-acc-test": ""}},
+			resource: Resource{ID: "id-1"},
+			want:     "tags unknown",
+		},
+		"tag key match": {
+			options:  Options{Tags: map[string]string{"tf-acc-test": ""}},
+			resource: Resource{ID: "id-1", Tags: map[string]string{"tf-acc-test": "x"}},
+		},
+		"tag key missing": {
+			options:  Options{Tags: map[string]string{"tf-acc-test": ""}},
+			resource: Resource{ID: "id-1", Tags: map[string]string{}},
+			want:     "not tagged tf-acc-test",
+		},
+		"tag value mismatch": {
+			options:  Options{Tags: map[string]string{"Owner": "ci"}},
+			resource: Resource{ID: "id-1", Tags: map[string]string{"Owner": "me"}},
+			want:     "not tagged Owner",
+		},
+		"creation time unknown": {
+			options:  Options{MinAge: 2 * time.Hour},
+			resource: Resource{ID: "id-1"},
+			want:     "creation time unknown",
+		},
+		"too new": {
+			options:  Options{MinAge: 2 * time.Hour},
+			resource: Resource{CreatedAt: now.Add(-time.Hour), ID: "id-1"},
+			want:     "created 1h0m0s ago",
+		},
+		"old enough": {
+			options:  Options{MinAge: 2 * time.Hour},
+			resource: Resource{CreatedAt: now.Add(-3 * time.Hour), ID: "id-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.options.Skip(testCase.resource, now), testCase.want; got != want {
+				t.Errorf("Skip() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestOptionsIncomplete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		options  Options
+		resource Resource
+		want     bool
+	}{
+		"no filters": {
+			resource: Resource{ID: "id-1"},
+		},
+		"ARN unknown": {
+			options:  Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			resource: Resource{ID: "id-1"},
+			want:     true,
+		},
+		"ARN known": {
+			options:  Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			resource: Resource{ARN: "arn:aws:s3:::c", ID: "c"},                        //lintignore:AWSAT005
+		},
+		"tags unknown": {
+			options:  Options{Tags: map[string]string{"tf-acc-test": ""}},
+			resource: Resource{ID: "id-1"},
+			want:     true,
+		},
+		"tags unknown, ARN known": {
+			options:  Options{Tags: map[string]string{"tf-acc-test": ""}},
+			resource: Resource{ARN: "arn:aws:s3:::c", ID: "c"}, //lintignore:AWSAT005
+		},
+		"tags known": {
+			options:  Options{Tags: map[string]string{"tf-acc-test": ""}},
+			resource: Resource{ID: "id-1", Tags: map[string]string{}},
+		},
+		"creation time unknown": {
+			options:  Options{MinAge: 2 * time.Hour},
+			resource: Resource{ID: "id-1"},
+			want:     true,
+		},
+		"creation time known": {
+			options:  Options{MinAge: 2 * time.Hour},
+			resource: Resource{CreatedAt: now, ID: "id-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.options.Incomplete(testCase.resource), testCase.want; got != want {
+				t.Errorf("Incomplete() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestOptionsFromEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "protected")
+	if err := os.WriteFile(path, []byte("# Comment\narn:aws:s3:::b\n\nid-1\n"), 0600); err != nil { //lintignore:AWSAT005
+		t.Fatal(err)
+	}
+
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepMinAge, "2h")
+	t.Setenv(envvar.SweepProtectedARNsFile, path)
+	t.Setenv(envvar.SweepReportFile, "report.json")
+	t.Setenv(envvar.SweepTags, "tf-acc-test, Owner=ci")
+
+	got, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Options{
+		DryRun:        true,
+		MinAge:        2 * time.Hour,
+		ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true, "id-1": true}, //lintignore:AWSAT005
+		ReportFile:    "report.json",
+		Tags:          map[string]string{"Owner": "ci", "tf-acc-test": ""},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv(envvar.SweepMinAge, "two hours")
+
+	if _, err := OptionsFromEnv(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport(true)
+	report.Swept("us-west-2", "aws_sqs_queue", Resource{ID: "q1"})                //lintignore:AWSAT003
+	report.Skipped("us-west-2", "aws_sqs_queue", Resource{ID: "q2"}, "protected") //lintignore:AWSAT003
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := report.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+  "dry_run": true,
+  "regions": {
+    "us-west-2": {
+      "failed": [],
+      "skipped": [
+        {
+          "id": "q2",
+          "reason": "protected",
+          "resource_type": "aws_sqs_queue"
+        }
+      ],
+      "swept": [
+        {
+          "id": "q1",
+          "resource_type": "aws_sqs_queue"
+        }
+      ]
+    }
+  }
+}`
+
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
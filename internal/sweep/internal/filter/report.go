@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Report is the record of the resources swept, skipped and failed in each Region during a sweeper run.
+type Report struct {
+	DryRun  bool                     `json:"dry_run"`
+	Regions map[string]*RegionReport `json:"regions"`
+
+	lock sync.Mutex
+}
+
+type RegionReport struct {
+	Failed  []ReportEntry `json:"failed"`
+	Skipped []ReportEntry `json:"skipped"`
+	Swept   []ReportEntry `json:"swept"`
+}
+
+type ReportEntry struct {
+	ARN          string `json:"arn,omitempty"`
+	Error        string `json:"error,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		DryRun:  dryRun,
+		Regions: make(map[string]*RegionReport),
+	}
+}
+
+func (r *Report) region(region string) *RegionReport {
+	v, ok := r.Regions[region]
+	if !ok {
+		v = &RegionReport{
+			Failed:  []ReportEntry{},
+			Skipped: []ReportEntry{},
+			Swept:   []ReportEntry{},
+		}
+		r.Regions[region] = v
+	}
+	return v
+}
+
+// Failed records a resource that could not be deleted.
+func (r *Report) Failed(region, resourceType string, resource Resource, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v := r.region(region)
+	v.Failed = append(v.Failed, ReportEntry{
+		ARN:          resource.ARN,
+		Error:        err.Error(),
+		ID:           resource.ID,
+		ResourceType: resourceType,
+	})
+}
+
+// Skipped records a resource that was not deleted.
+func (r *Report) Skipped(region, resourceType string, resource Resource, reason string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v := r.region(region)
+	v.Skipped = append(v.Skipped, ReportEntry{
+		ARN:          resource.ARN,
+		ID:           resource.ID,
+		Reason:       reason,
+		ResourceType: resourceType,
+	})
+}
+
+// Swept records a resource that was deleted, or would have been deleted in a dry run.
+func (r *Report) Swept(region, resourceType string, resource Resource) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v := r.region(region)
+	v.Swept = append(v.Swept, ReportEntry{
+		ARN:          resource.ARN,
+		ID:           resource.ID,
+		ResourceType: resourceType,
+	})
+}
+
+// Write writes the report as JSON to the specified file, replacing any previous contents.
+func (r *Report) Write(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
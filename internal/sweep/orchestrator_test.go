@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var (
+	// mutatingMethodName matches the names of AWS API operations that delete or modify resources.
+	mutatingMethodName = regexache.MustCompile(`^(Cancel|Delete|Deregister|Detach|Disable|Disassociate|Purge|Reject|Release|Remove|Revoke|Stop|Terminate|Unassign)`)
+
+	// mutatingFuncName matches the names of service package functions that delete or modify resources.
+	mutatingFuncName = regexache.MustCompile(`^(cancel|delete|deregister|detach|disable|disassociate|purge|reject|release|remove|revoke|stop|terminate|unassign)`)
+)
+
+// TestSweepersUseOrchestrator checks that sweepers don't delete resources themselves.
+// Resources must be deleted by Sweepables passed to SweepOrchestrator so that filters, dry runs and the report apply to them.
+func TestSweepersUseOrchestrator(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("..", "service", "*", "sweep.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal("no sweeper files found")
+	}
+
+	for _, file := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil || !isSweeperFunc(fd) {
+				continue
+			}
+
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				var name string
+				switch fun := call.Fun.(type) {
+				case *ast.Ident:
+					if mutatingFuncName.MatchString(fun.Name) {
+						name = fun.Name
+					}
+				case *ast.SelectorExpr:
+					// e.g. conn.DeleteWidget, but not sweepable.Delete.
+					if fun.Sel.Name != "Delete" && mutatingMethodName.MatchString(fun.Sel.Name) {
+						name = fun.Sel.Name
+					}
+				}
+
+				if name != "" {
+					t.Errorf("%s: sweeper %s calls %s; delete resources with Sweepables passed to SweepOrchestrator", fset.Position(call.Pos()), fd.Name.Name, name)
+				}
+
+				return true
+			})
+		}
+	}
+}
+
+// isSweeperFunc returns whether the specified function is a sweeper, i.e. it calls sweep.Context or returns Sweepables.
+func isSweeperFunc(fd *ast.FuncDecl) bool {
+	if fd.Recv != nil {
+		return false
+	}
+
+	if results := fd.Type.Results; results != nil {
+		for _, field := range results.List {
+			if v, ok := field.Type.(*ast.ArrayType); ok && isSelector(v.Elt, "sweep", "Sweepable") {
+				return true
+			}
+		}
+	}
+
+	var found bool
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && isSelector(call.Fun, "sweep", "Context") {
+			found = true
+		}
+		return !found
+	})
+
+	return found
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	v, ok := expr.(*ast.SelectorExpr)
+	if !ok || v.Sel.Name != name {
+		return false
+	}
+
+	id, ok := v.X.(*ast.Ident)
+	return ok && id.Name == pkg
+}
+
+type testSweepable struct {
+	description ResourceDescription
+	err         error
+}
+
+func (testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (s testSweepable) Describe(context.Context) ResourceDescription {
+	return ResourceDescription{ID: s.description.ID}
+}
+
+func (s testSweepable) ReadDescription(context.Context) (ResourceDescription, error) {
+	return s.description, s.err
+}
+
+type testWrapper struct {
+	sweepable Sweepable
+}
+
+func (testWrapper) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (w testWrapper) Unwrap() Sweepable {
+	return w.sweepable
+}
+
+func TestReadDescriptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	read := ResourceDescription{
+		ARN:       "arn:aws:sqs:us-west-2:123456789012:q", //lintignore:AWSAT003,AWSAT005
+		CreatedAt: createdAt,
+		ID:        "q",
+	}
+
+	testCases := map[string]struct {
+		options   filter.Options
+		sweepable Sweepable
+		want      ResourceDescription
+	}{
+		"no filters": {
+			sweepable: testSweepable{description: read},
+			want:      ResourceDescription{ID: "q"},
+		},
+		"min age": {
+			options:   filter.Options{MinAge: time.Hour},
+			sweepable: testSweepable{description: read},
+			want:      read,
+		},
+		"protected ARNs": {
+			options:   filter.Options{ProtectedARNs: map[string]bool{"arn:aws:s3:::b": true}}, //lintignore:AWSAT005
+			sweepable: testSweepable{description: read},
+			want:      read,
+		},
+		"wrapped": {
+			options:   filter.Options{MinAge: time.Hour},
+			sweepable: testWrapper{sweepable: testSweepable{description: read}},
+			want:      read,
+		},
+		"error": {
+			options:   filter.Options{MinAge: time.Hour},
+			sweepable: testSweepable{description: read, err: errors.New("test")},
+			want:      ResourceDescription{ID: "q"},
+		},
+		"described": {
+			options:   filter.Options{MinAge: time.Hour},
+			sweepable: WithDescription(testSweepable{description: read}, ResourceDescription{ID: "q"}),
+			want:      ResourceDescription{ID: "q"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sweepables := []Sweepable{testCase.sweepable}
+			descriptions := []ResourceDescription{describe(ctx, testCase.sweepable)}
+
+			readDescriptions(ctx, &testCase.options, sweepables, descriptions)
+
+			if diff := cmp.Diff(descriptions[0], testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const defaultSweeperParallelism = 4
+
+var (
+	// sweepers is the registry of resource sweepers, keyed by name.
+	sweepers = make(map[string]*resource.Sweeper)
+
+	// sweeperFuncs maps the names of registered sweeper functions to the names of their sweepers.
+	// A function shared by several sweepers maps to "".
+	sweeperFuncs = make(map[string]string)
+)
+
+// AddTestSweepers registers a resource sweeper.
+// Use in place of resource.AddTestSweepers so that the sweeper is run by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: function already exists in map", name)
+	}
+
+	sweepers[name] = s
+	addSweeperFunc(sweeperFuncs, name, s.F)
+}
+
+// addSweeperFunc records the name of the sweeper with the specified function.
+func addSweeperFunc(funcs map[string]string, name string, f func(string) error) {
+	if f == nil {
+		return
+	}
+
+	v := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if v == nil {
+		return
+	}
+
+	if _, ok := funcs[v.Name()]; ok {
+		// e.g. the function of sweepers registered with awsv2.Register, which set the resource type themselves.
+		funcs[v.Name()] = ""
+	} else {
+		funcs[v.Name()] = name
+	}
+}
+
+// TestMain runs the registered resource sweepers if the -sweep flag is set, otherwise tests are run as normal.
+//
+// In contrast to resource.TestMain, sweepers run concurrently across and within Regions with the parallelism set
+// by the TF_AWS_SWEEP_PARALLELISM environment variable. A sweeper runs once all its dependencies have completed.
+// A failing sweeper prevents only the sweepers that depend on it from running, unless -sweep-allow-failures is set.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	// The sweeper flags are defined by the terraform-plugin-testing module.
+	regions := flagValue("sweep")
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	parallelism := defaultSweeperParallelism
+	if v := os.Getenv(envvar.SweepParallelism); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			log.Printf("[ERROR] Invalid value for environment variable %s: %q", envvar.SweepParallelism, v)
+			os.Exit(1)
+		}
+		parallelism = n
+	}
+
+	r, err := newRunner(filterSweepers(flagValue("sweep-run"), sweepers), allowFailures, parallelism)
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	if err := r.run(splitRegions(regions)); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// splitRegions splits a comma-separated list of Regions.
+func splitRegions(s string) []string {
+	regions := strings.Split(s, ",")
+	for i, v := range regions {
+		regions[i] = strings.TrimSpace(v)
+	}
+
+	return slices.DeleteFunc(regions, func(v string) bool { return v == "" })
+}
+
+// filterSweepers returns the sweepers whose names contain any of the specified comma-separated (case-insensitive) names,
+// together with all their dependencies.
+func filterSweepers(names string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if names == "" {
+		return source
+	}
+
+	filters := strings.Split(strings.ToLower(names), ",")
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := source[name]
+		if !ok {
+			// Reported as a missing dependency.
+			return
+		}
+
+		result[name] = s
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		if slices.ContainsFunc(filters, func(filter string) bool {
+			return filter != "" && strings.Contains(strings.ToLower(name), filter)
+		}) {
+			add(name)
+		}
+	}
+
+	return result
+}
+
+type runner struct {
+	allowFailures bool
+	dependencies  map[string][]string
+	order         []string
+	parallelism   int
+	sweepers      map[string]*resource.Sweeper
+}
+
+// newRunner returns a runner for the specified sweepers.
+// Returns an error if a sweeper has a missing dependency or the sweepers' dependencies contain a cycle.
+func newRunner(sweepers map[string]*resource.Sweeper, allowFailures bool, parallelism int) (*runner, error) {
+	g := depgraph.New()
+
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	var errs []error
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				errs = append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, fmt.Errorf("sweepers: %w", err)
+	}
+
+	dependencies := make(map[string][]string, len(names))
+	for _, name := range names {
+		dependencies[name], _ = g.DirectDependenciesOf(name)
+	}
+
+	return &runner{
+		allowFailures: allowFailures,
+		dependencies:  dependencies,
+		order:         order,
+		parallelism:   parallelism,
+		sweepers:      sweepers,
+	}, nil
+}
+
+// errDependencyFailed is the result of a sweeper not run because one of its dependencies failed.
+var errDependencyFailed = errors.New("dependency failed")
+
+// run runs the sweepers in the specified Regions.
+// Returns an error if any sweeper fails.
+func (r *runner) run(regions []string) error {
+	semaphore := make(chan struct{}, r.parallelism)
+	results := make(map[string]map[string]error, len(regions))
+	var wg sync.WaitGroup
+
+	start := time.Now()
+	log.Printf("[DEBUG] Running %d Sweepers in %d regions with parallelism %d", len(r.sweepers), len(regions), r.parallelism)
+
+	for _, region := range regions {
+		results[region] = r.runRegion(region, semaphore, &wg)
+	}
+
+	wg.Wait()
+
+	log.Printf("Completed Sweepers in %s", time.Since(start))
+
+	var failed bool
+	for _, region := range regions {
+		var succeeded, unsuccessful []string
+		for _, name := range r.order {
+			if err := results[region][name]; err == nil {
+				succeeded = append(succeeded, name)
+			} else {
+				unsuccessful = append(unsuccessful, fmt.Sprintf("%s: %s", name, err))
+			}
+		}
+
+		log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+		for _, v := range succeeded {
+			log.Printf("\t- %s\n", v)
+		}
+
+		if len(unsuccessful) > 0 {
+			failed = true
+			log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+			for _, v := range unsuccessful {
+				log.Printf("\t- %s\n", v)
+			}
+		}
+	}
+
+	if failed {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// runRegion starts the sweepers in the specified Region.
+// The returned results are complete once wg is done.
+func (r *runner) runRegion(region string, semaphore chan struct{}, wg *sync.WaitGroup) map[string]error {
+	results := make(map[string]error, len(r.order))
+	done := make(map[string]chan struct{}, len(r.order))
+	var lock sync.Mutex
+
+	for _, name := range r.order {
+		done[name] = make(chan struct{})
+		results[name] = nil
+	}
+
+	for _, name := range r.order {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[name])
+
+			var err error
+			for _, dependency := range r.dependencies[name] {
+				<-done[dependency]
+
+				lock.Lock()
+				if results[dependency] != nil && !r.allowFailures {
+					err = fmt.Errorf("%w: %s", errDependencyFailed, dependency)
+				}
+				lock.Unlock()
+			}
+
+			if err == nil {
+				semaphore <- struct{}{}
+				err = r.runSweeper(region, name)
+				<-semaphore
+			} else {
+				log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, err)
+			}
+
+			lock.Lock()
+			results[name] = err
+			lock.Unlock()
+		}()
+	}
+
+	return results
+}
+
+func (r *runner) runSweeper(region, name string) error {
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+	start := time.Now()
+	err := r.sweepers[name].F(region)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+	if err != nil {
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+	}
+
+	return err
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRegisteredSweepers(t *testing.T) {
+	t.Parallel()
+
+	// Sweepers are registered in TestMain.
+	if len(sweepers) == 0 {
+		t.Skip("no sweepers registered")
+	}
+
+	if _, err := newRunner(sweepers, false, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCallerResourceType(t *testing.T) {
+	t.Parallel()
+
+	funcs := make(map[string]string)
+	var got string
+	newContext := func() { got = callerResourceType(funcs) } // Stands in for Context.
+
+	sweeperA := func(string) error { newContext(); return nil }
+	sweeperB := func(string) error {
+		helper := func() { newContext() }
+		helper()
+		return nil
+	}
+	shared := func(string) error { newContext(); return nil }
+	unregistered := func(string) error { newContext(); return nil }
+
+	addSweeperFunc(funcs, "aws_a", sweeperA)
+	addSweeperFunc(funcs, "aws_b", sweeperB)
+	addSweeperFunc(funcs, "aws_c", shared)
+	addSweeperFunc(funcs, "aws_d", shared)
+
+	testCases := map[string]struct {
+		f    func(string) error
+		want string
+	}{
+		"direct": {
+			f:    sweeperA,
+			want: "aws_a",
+		},
+		"nested": {
+			f:    sweeperB,
+			want: "aws_b",
+		},
+		"shared": {
+			f: shared,
+		},
+		"unregistered": {
+			f: unregistered,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got = ""
+			if err := testCase.f("us-west-2"); err != nil { //lintignore:AWSAT003
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("callerResourceType() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	source := map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_c"}},
+		"aws_c": {Name: "aws_c"},
+		"aws_d": {Name: "aws_d"},
+	}
+
+	testCases := map[string]struct {
+		names string
+		want  []string
+	}{
+		"all": {
+			want: []string{"aws_a", "aws_b", "aws_c", "aws_d"},
+		},
+		"dependencies": {
+			names: "aws_a",
+			want:  []string{"aws_a", "aws_b", "aws_c"},
+		},
+		"multiple": {
+			names: "AWS_C,aws_d",
+			want:  []string{"aws_c", "aws_d"},
+		},
+		"none": {
+			names: "aws_e",
+			want:  []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make([]string, 0)
+			for name := range filterSweepers(testCase.names, source) {
+				got = append(got, name)
+			}
+			slices.Sort(got)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRunner(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers      map[string]*resource.Sweeper
+		expectedError string
+	}{
+		"valid": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b"},
+			},
+		},
+		"missing dependency": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+			},
+			expectedError: "sweeper (aws_a) has dependency (aws_b), but that sweeper was not found",
+		},
+		"cycle": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+			},
+			expectedError: "dependency cycle: aws_a -> aws_b -> aws_a",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newRunner(testCase.sweepers, false, 1)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestRunnerRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowFailures bool
+		wantRun       []string
+	}{
+		"failure blocks dependents": {
+			wantRun: []string{
+				"us-east-1/aws_c", //lintignore:AWSAT003
+				"us-east-1/aws_d", //lintignore:AWSAT003
+				"us-west-2/aws_c", //lintignore:AWSAT003
+				"us-west-2/aws_d", //lintignore:AWSAT003
+			},
+		},
+		"allow failures": {
+			allowFailures: true,
+			wantRun: []string{
+				"us-east-1/aws_a", //lintignore:AWSAT003
+				"us-east-1/aws_b", //lintignore:AWSAT003
+				"us-east-1/aws_c", //lintignore:AWSAT003
+				"us-east-1/aws_d", //lintignore:AWSAT003
+				"us-west-2/aws_a", //lintignore:AWSAT003
+				"us-west-2/aws_b", //lintignore:AWSAT003
+				"us-west-2/aws_c", //lintignore:AWSAT003
+				"us-west-2/aws_d", //lintignore:AWSAT003
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var run []string
+			completed := make(map[string]bool)
+
+			f := func(name string, dependencies []string, err error) func(string) error {
+				return func(region string) error {
+					lock.Lock()
+					defer lock.Unlock()
+
+					for _, dependency := range dependencies {
+						if !completed[region+"/"+dependency] {
+							t.Errorf("%s ran before dependency %s in %s", name, dependency, region)
+						}
+					}
+
+					run = append(run, region+"/"+name)
+					completed[region+"/"+name] = true
+
+					return err
+				}
+			}
+
+			// aws_a -> aws_b -> aws_c (fails); aws_d is independent.
+			sweepers := map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}, F: f("aws_a", []string{"aws_b"}, nil)},
+				"aws_b": {Name: "aws_b", Dependencies: []string{"aws_c"}, F: f("aws_b", []string{"aws_c"}, nil)},
+				"aws_c": {Name: "aws_c", F: f("aws_c", nil, errors.New("failed"))},
+				"aws_d": {Name: "aws_d", F: f("aws_d", nil, nil)},
+			}
+
+			r, err := newRunner(sweepers, testCase.allowFailures, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := r.run([]string{"us-west-2", "us-east-1"}); err == nil { //lintignore:AWSAT003
+				t.Error("expected error")
+			}
+
+			slices.Sort(run)
+
+			if diff := cmp.Diff(run, testCase.wantRun); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
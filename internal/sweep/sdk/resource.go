@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -39,6 +40,34 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Describe describes the resource from the attributes set in its ResourceData.
+func (sr *sweepResource) Describe(context.Context) filter.Resource {
+	return describeResource(sr.resource, sr.d)
+}
+
+// ReadDescription reads the resource into a copy of its ResourceData and describes it from the attributes that are set.
+func (sr *sweepResource) ReadDescription(ctx context.Context) (filter.Resource, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	d := sr.resource.Data(sr.d.State())
+	if err := ReadResource(ctx, sr.resource, d, sr.meta); err != nil {
+		return filter.Resource{}, err
+	}
+
+	return describeResource(sr.resource, d), nil
+}
+
+func describeResource(resource *schema.Resource, d *schema.ResourceData) filter.Resource {
+	schemaMap := resource.SchemaMap()
+
+	return filter.NewResource(d.Id(), func(name string) (any, bool) {
+		if _, ok := schemaMap[name]; !ok {
+			return nil, false
+		}
+		return d.GetOk(name)
+	})
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -29,10 +32,17 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// It is safe to call from sweepers running concurrently.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -104,20 +114,238 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// ResourceDescription describes the resource that a Sweepable deletes.
+// Zero values are unknown.
+type ResourceDescription = filter.Resource
+
+// Describer is implemented by Sweepables that can describe the resource that they delete.
+// Descriptions are used to filter the resources that are deleted and in the sweeper run report.
+type Describer interface {
+	Describe(ctx context.Context) ResourceDescription
+}
+
+type describedSweepable struct {
+	Sweepable
+	description ResourceDescription
+}
+
+func (s describedSweepable) Describe(context.Context) ResourceDescription {
+	return s.description
+}
+
+// WithDescription returns a Sweepable that deletes a resource with the specified description, e.g. a creation time known only to the sweeper.
+func WithDescription(sweepable Sweepable, description ResourceDescription) Sweepable {
+	return describedSweepable{
+		Sweepable:   sweepable,
+		description: description,
+	}
+}
+
+// DescriptionReader is implemented by Sweepables that can read the resource that they delete to describe it.
+// Resources are read only if the filters need data that is missing from their descriptions, as it costs an API call per resource.
+type DescriptionReader interface {
+	ReadDescription(ctx context.Context) (ResourceDescription, error)
+}
+
+// Wrapper is implemented by Sweepables that wrap another Sweepable, e.g. to ignore some errors.
+// The wrapped Sweepable describes the resource.
+type Wrapper interface {
+	Unwrap() Sweepable
+}
+
+func describe(ctx context.Context, sweepable Sweepable) ResourceDescription {
+	switch v := sweepable.(type) {
+	case Describer:
+		return v.Describe(ctx)
+	case Wrapper:
+		return describe(ctx, v.Unwrap())
+	}
+
+	return ResourceDescription{}
+}
+
+func descriptionReader(sweepable Sweepable) (DescriptionReader, bool) {
+	switch v := sweepable.(type) {
+	case DescriptionReader:
+		return v, true
+	case Wrapper:
+		return descriptionReader(v.Unwrap())
+	}
+
+	return nil, false
+}
+
+var (
+	// filterOptions are the sweeper filtering options, read once from environment variables.
+	filterOptions = sync.OnceValues(filter.OptionsFromEnv)
+
+	// report is the record of the sweeper run.
+	report = sync.OnceValue(func() *filter.Report {
+		opts, _ := filterOptions()
+		return filter.NewReport(opts != nil && opts.DryRun)
+	})
+)
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
-	if len(sweepables) == 0 {
-		tflog.Info(ctx, "No resources to sweep")
+	opts, err := filterOptions()
+	if err != nil {
+		return err
 	}
 
+	region, resourceType := regionFromContext(ctx), resourceTypeFromContext(ctx)
+	report := report()
+	if opts.ReportFile != "" {
+		defer func() {
+			if err := report.Write(opts.ReportFile); err != nil {
+				tflog.Warn(ctx, "Writing sweeper report", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}()
+	}
+
+	descriptions := make([]ResourceDescription, len(sweepables))
+	for i, sweepable := range sweepables {
+		descriptions[i] = describe(ctx, sweepable)
+	}
+
+	readDescriptions(ctx, opts, sweepables, descriptions)
+
+	if opts.FiltersTags() {
+		lookUpTags(ctx, region, descriptions)
+	}
+
+	now := time.Now()
 	var g multierror.Group
+	var n int
+
+	for i, sweepable := range sweepables {
+		description := descriptions[i]
+		ctx := ctx
+		if description.ID != "" {
+			ctx = tflog.SetField(ctx, "id", description.ID)
+		}
+		if description.ARN != "" {
+			ctx = tflog.SetField(ctx, "arn", description.ARN)
+		}
+
+		if reason := opts.Skip(description, now); reason != "" {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"reason": reason,
+			})
+			report.Skipped(region, resourceType, description, reason)
+			continue
+		}
+
+		n++
+
+		if opts.DryRun {
+			tflog.Info(ctx, "Would sweep resource")
+			report.Swept(region, resourceType, description)
+			continue
+		}
 
-	for _, sweepable := range sweepables {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, optFns...)
+			err := sweepable.Delete(ctx, optFns...)
+
+			if err != nil {
+				report.Failed(region, resourceType, description, err)
+			} else {
+				report.Swept(region, resourceType, description)
+			}
+
+			return err
 		})
 	}
 
+	if n == 0 {
+		tflog.Info(ctx, "No resources to sweep")
+	}
+
 	return g.Wait().ErrorOrNil()
 }
 
+// readDescriptionsParallelism is the maximum number of resources read concurrently to complete their descriptions.
+const readDescriptionsParallelism = 10
+
+// readDescriptions completes the descriptions of resources that are missing data needed by the filters by reading the resources.
+func readDescriptions(ctx context.Context, opts *filter.Options, sweepables []Sweepable, descriptions []ResourceDescription) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, readDescriptionsParallelism)
+
+	for i, sweepable := range sweepables {
+		reader, ok := descriptionReader(sweepable)
+		if !ok || !opts.Incomplete(descriptions[i]) {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			description, err := reader.ReadDescription(ctx)
+			if err != nil {
+				tflog.Warn(ctx, "Reading resource", map[string]any{
+					"id":    descriptions[i].ID,
+					"error": err.Error(),
+				})
+				return
+			}
+
+			// Data that was already described takes precedence.
+			if descriptions[i].ARN == "" {
+				descriptions[i].ARN = description.ARN
+			}
+			if descriptions[i].CreatedAt.IsZero() {
+				descriptions[i].CreatedAt = description.CreatedAt
+			}
+			if descriptions[i].Tags == nil {
+				descriptions[i].Tags = description.Tags
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// lookUpTags looks up the tags of described resources with known ARNs and unknown tags using the Resource Groups Tagging API.
+func lookUpTags(ctx context.Context, region string, descriptions []ResourceDescription) {
+	var arns []string
+	for _, v := range descriptions {
+		if v.ARN != "" && v.Tags == nil {
+			arns = append(arns, v.ARN)
+		}
+	}
+
+	if len(arns) == 0 || region == "" {
+		return
+	}
+
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		tflog.Warn(ctx, "Looking up resource tags", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tags, err := tftags.ListResourceTags(ctx, client.ResourceGroupsTaggingAPIClient(ctx), arns)
+	if err != nil {
+		tflog.Warn(ctx, "Looking up resource tags", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for i, v := range descriptions {
+		if v.ARN != "" && v.Tags == nil {
+			// Resources without tags aren't returned.
+			descriptions[i].Tags = tags[v.ARN].Map()
+		}
+	}
+}
+
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// PolicySeverity is the severity of tag policy violations.
+type PolicySeverity string
+
+const (
+	PolicySeverityError   PolicySeverity = "error"
+	PolicySeverityWarning PolicySeverity = "warning"
+)
+
+func (PolicySeverity) Values() []PolicySeverity {
+	return []PolicySeverity{
+		PolicySeverityError,
+		PolicySeverityWarning,
+	}
+}
+
+// PolicyKeyCase is a casing rule for tag keys.
+type PolicyKeyCase string
+
+const (
+	PolicyKeyCaseCamel  PolicyKeyCase = "camel"
+	PolicyKeyCaseLower  PolicyKeyCase = "lower"
+	PolicyKeyCasePascal PolicyKeyCase = "pascal"
+	PolicyKeyCaseUpper  PolicyKeyCase = "upper"
+)
+
+func (PolicyKeyCase) Values() []PolicyKeyCase {
+	return []PolicyKeyCase{
+		PolicyKeyCaseCamel,
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseUpper,
+	}
+}
+
+// PolicyConfig contains rules enforced on resource tags during planning.
+type PolicyConfig struct {
+	// AllowedValues constrains the values of tags with the specified keys.
+	AllowedValues map[string]*regexp.Regexp
+	// CanonicalKeys maps lower case tag keys to their required casing.
+	CanonicalKeys map[string]string
+	// KeyCase is the casing rule that all tag keys must follow.
+	KeyCase PolicyKeyCase
+	// MaxTags limits the number of tags on a resource, by service package name.
+	MaxTags map[string]int
+	// RequiredKeys are the tag keys that every taggable resource must have.
+	RequiredKeys []string
+	// Severity of tag policy violations. Defaults to error.
+	Severity PolicySeverity
+}
+
+// IsWarning returns whether tag policy violations are reported as warnings instead of errors.
+func (p *PolicyConfig) IsWarning() bool {
+	return p != nil && p.Severity == PolicySeverityWarning
+}
+
+// Validate returns all tag policy violations for a resource's tags.
+// The tags should include any provider configured default tags.
+func (p *PolicyConfig) Validate(servicePackageName string, tags KeyValueTags) []string {
+	if p == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, k := range p.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			violations = append(violations, fmt.Sprintf("required tag %q is missing", k))
+		}
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if canonical, ok := p.CanonicalKeys[strings.ToLower(k)]; ok && k != canonical {
+			violations = append(violations, fmt.Sprintf("tag key %q must be cased as %q", k, canonical))
+		} else if !p.KeyCase.matches(k) {
+			violations = append(violations, fmt.Sprintf("tag key %q is not %s case", k, p.KeyCase))
+		}
+
+		if re, ok := p.AllowedValues[k]; ok {
+			if v := tags.KeyValue(k); v == nil || !re.MatchString(*v) {
+				violations = append(violations, fmt.Sprintf("tag %q value %q does not match %q", k, aws.ToString(v), re.String()))
+			}
+		}
+	}
+
+	if n, ok := p.MaxTags[servicePackageName]; ok && len(tags) > n {
+		violations = append(violations, fmt.Sprintf("%d tags exceed the maximum of %d for %s resources", len(tags), n, servicePackageName))
+	}
+
+	return violations
+}
+
+var (
+	policyKeyCaseCamelRegexp  = regexache.MustCompile(`^[a-z][0-9A-Za-z]*$`)
+	policyKeyCasePascalRegexp = regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`)
+)
+
+// matches returns whether a tag key follows the casing rule.
+// For camel and Pascal case, each segment of a key delimited by ':' or '/' is checked separately.
+func (c PolicyKeyCase) matches(k string) bool {
+	var re *regexp.Regexp
+
+	switch c {
+	case PolicyKeyCaseLower:
+		return k == strings.ToLower(k)
+	case PolicyKeyCaseUpper:
+		return k == strings.ToUpper(k)
+	case PolicyKeyCaseCamel:
+		re = policyKeyCaseCamelRegexp
+	case PolicyKeyCasePascal:
+		re = policyKeyCasePascalRegexp
+	default:
+		return true
+	}
+
+	for segment := range strings.FieldsFuncSeq(k, func(r rune) bool { return r == ':' || r == '/' }) {
+		if !re.MatchString(segment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// organizationsPolicy is the subset of the AWS Organizations tag policy syntax used by the provider.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type organizationsPolicy struct {
+	Tags map[string]struct {
+		TagKey *struct {
+			Assign string `json:"@@assign"`
+		} `json:"tag_key"`
+		TagValue *struct {
+			Assign []string `json:"@@assign"`
+		} `json:"tag_value"`
+	} `json:"tags"`
+}
+
+// ImportOrganizationsPolicy adds the rules of an AWS Organizations tag policy document to the policy.
+// Each tag key's required casing becomes a canonical key and its allowed values, which may end with a '*' wildcard,
+// become an allowed values rule unless one is already configured for that key.
+func (p *PolicyConfig) ImportOrganizationsPolicy(document []byte) error {
+	var policy organizationsPolicy
+
+	if err := json.Unmarshal(document, &policy); err != nil {
+		return fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	for name, v := range policy.Tags {
+		key := name
+		if v.TagKey != nil && v.TagKey.Assign != "" {
+			key = v.TagKey.Assign
+		}
+
+		if p.CanonicalKeys == nil {
+			p.CanonicalKeys = make(map[string]string)
+		}
+		p.CanonicalKeys[strings.ToLower(key)] = key
+
+		if v.TagValue == nil || len(v.TagValue.Assign) == 0 {
+			continue
+		}
+
+		if _, ok := p.AllowedValues[key]; ok {
+			continue
+		}
+
+		patterns := make([]string, 0, len(v.TagValue.Assign))
+		for _, value := range v.TagValue.Assign {
+			if prefix, ok := strings.CutSuffix(value, "*"); ok {
+				patterns = append(patterns, regexp.QuoteMeta(prefix)+".*")
+			} else {
+				patterns = append(patterns, regexp.QuoteMeta(value))
+			}
+		}
+		slices.Sort(patterns)
+
+		re, err := regexp.Compile(`^(?:` + strings.Join(patterns, "|") + `)$`)
+		if err != nil {
+			return fmt.Errorf("parsing AWS Organizations tag policy (%s) values: %w", key, err)
+		}
+
+		if p.AllowedValues == nil {
+			p.AllowedValues = make(map[string]*regexp.Regexp)
+		}
+		p.AllowedValues[key] = re
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := map[string]struct {
+		policy             *PolicyConfig
+		servicePackageName string
+		tags               KeyValueTags
+		expected           []string
+	}{
+		"nil policy": {
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+		},
+		"required keys present": {
+			policy: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			tags: New(ctx, map[string]string{"CostCenter": "100", "Owner": "team"}),
+		},
+		"required keys missing": {
+			policy: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			tags: New(ctx, map[string]string{"Owner": "team"}),
+			expected: []string{
+				`required tag "CostCenter" is missing`,
+			},
+		},
+		"allowed values": {
+			policy: &PolicyConfig{
+				AllowedValues: map[string]*regexp.Regexp{
+					"Environment": regexache.MustCompile(`^(?:dev|prod)$`),
+				},
+			},
+			tags: New(ctx, map[string]string{"Environment": "test"}),
+			expected: []string{
+				`tag "Environment" value "test" does not match "^(?:dev|prod)$"`,
+			},
+		},
+		"key case lower": {
+			policy: &PolicyConfig{
+				KeyCase: PolicyKeyCaseLower,
+			},
+			tags: New(ctx, map[string]string{"cost-center": "100", "Owner": "team"}),
+			expected: []string{
+				`tag key "Owner" is not lower case`,
+			},
+		},
+		"key case pascal": {
+			policy: &PolicyConfig{
+				KeyCase: PolicyKeyCasePascal,
+			},
+			tags: New(ctx, map[string]string{"Team:CostCenter": "100", "team:owner": "team"}),
+			expected: []string{
+				`tag key "team:owner" is not pascal case`,
+			},
+		},
+		"key case camel": {
+			policy: &PolicyConfig{
+				KeyCase: PolicyKeyCaseCamel,
+			},
+			tags: New(ctx, map[string]string{"costCenter": "100", "Owner": "team"}),
+			expected: []string{
+				`tag key "Owner" is not camel case`,
+			},
+		},
+		"canonical keys": {
+			policy: &PolicyConfig{
+				CanonicalKeys: map[string]string{"costcenter": "CostCenter"},
+				KeyCase:       PolicyKeyCaseLower,
+			},
+			tags: New(ctx, map[string]string{"costcenter": "100"}),
+			expected: []string{
+				`tag key "costcenter" must be cased as "CostCenter"`,
+			},
+		},
+		"max tags": {
+			policy: &PolicyConfig{
+				MaxTags: map[string]int{"ec2": 1},
+			},
+			servicePackageName: "ec2",
+			tags:               New(ctx, map[string]string{"key1": "value1", "key2": "value2"}),
+			expected: []string{
+				"2 tags exceed the maximum of 1 for ec2 resources",
+			},
+		},
+		"max tags other service": {
+			policy: &PolicyConfig{
+				MaxTags: map[string]int{"ec2": 1},
+			},
+			servicePackageName: "s3",
+			tags:               New(ctx, map[string]string{"key1": "value1", "key2": "value2"}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Validate(testCase.servicePackageName, testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigImportOrganizationsPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	policy := &PolicyConfig{
+		AllowedValues: map[string]*regexp.Regexp{
+			"Owner": regexache.MustCompile(`^team-.+$`),
+		},
+	}
+
+	document := []byte(`{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200", "300*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "owner": {
+      "tag_key": {"@@assign": "Owner"},
+      "tag_value": {"@@assign": ["anyone"]}
+    }
+  }
+}`)
+
+	if err := policy.ImportOrganizationsPolicy(document); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(policy.CanonicalKeys, map[string]string{"costcenter": "CostCenter", "owner": "Owner"}); diff != "" {
+		t.Errorf("unexpected canonical keys diff (+wanted, -got): %s", diff)
+	}
+
+	got := policy.Validate("", New(ctx, map[string]string{"CostCenter": "3001", "Owner": "team-a"}))
+	if len(got) != 0 {
+		t.Errorf("unexpected violations: %v", got)
+	}
+
+	got = policy.Validate("", New(ctx, map[string]string{"CostCenter": "1000", "owner": "anyone"}))
+	expected := []string{
+		`tag "CostCenter" value "1000" does not match "^(?:100|200|300.*)$"`,
+		`tag key "owner" must be cased as "Owner"`,
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected violations diff (+wanted, -got): %s", diff)
+	}
+
+	if err := policy.ImportOrganizationsPolicy([]byte(`{`)); err == nil {
+		t.Error("expected error for invalid policy document")
+	}
+}
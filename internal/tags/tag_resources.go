@@ -4,8 +4,18 @@
 package tags
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
 )
 
 const (
@@ -31,3 +41,255 @@ func SetResourceID(identifier string, key string) string {
 
 	return resourceID
 }
+
+const (
+	// Resource Groups Tagging API quotas.
+	getResourcesMaxARNs   = 100
+	tagResourcesMaxARNs   = 20
+	tagResourcesMaxTags   = 50
+	untagResourcesMaxKeys = 50
+)
+
+const (
+	// resourceTagsBatchDelay is how long a batch of tag updates waits for concurrent updates of other resources with the same tag changes.
+	resourceTagsBatchDelay = 100 * time.Millisecond
+)
+
+// ResourceGroupsTaggingAPIClient is the subset of the Resource Groups Tagging API used to tag resources of any service by ARN.
+type ResourceGroupsTaggingAPIClient interface {
+	GetResources(context.Context, *resourcegroupstaggingapi.GetResourcesInput, ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+	TagResources(context.Context, *resourcegroupstaggingapi.TagResourcesInput, ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.TagResourcesOutput, error)
+	UntagResources(context.Context, *resourcegroupstaggingapi.UntagResourcesInput, ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.UntagResourcesOutput, error)
+}
+
+// ListResourceTags lists the tags of the resources with the specified ARNs using the Resource Groups Tagging API.
+// The returned map has no entry for resources that have no tags or that do not exist.
+func ListResourceTags(ctx context.Context, conn ResourceGroupsTaggingAPIClient, arns []string) (map[string]KeyValueTags, error) {
+	output := make(map[string]KeyValueTags)
+
+	for arns := range slices.Chunk(arns, getResourcesMaxARNs) {
+		input := resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: arns,
+		}
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("listing tags for resources: %w", err)
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				tags := make(map[string]*string, len(v.Tags))
+				for _, tag := range v.Tags {
+					tags[aws.ToString(tag.Key)] = tag.Value
+				}
+
+				output[aws.ToString(v.ResourceARN)] = New(ctx, tags)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// UpdateResourceTags updates the tags of the resources with the specified ARNs using the Resource Groups Tagging API.
+// Each TagResources or UntagResources call changes the tags of a batch of resources, so that a tag change can be applied across many resources in a few calls.
+// System tags of the specified service are ignored.
+func UpdateResourceTags(ctx context.Context, conn ResourceGroupsTaggingAPIClient, serviceName string, arns []string, oldTagsMap, newTagsMap any) error {
+	removedTags, updatedTags := resourceTagChanges(ctx, serviceName, oldTagsMap, newTagsMap)
+	failed := updateResourceTags(ctx, conn, arns, removedTags, updatedTags)
+
+	// An error for a whole call is returned for each of the call's resources.
+	var errs []error
+	for _, arn := range arns {
+		if err, ok := failed[arn]; ok && !slices.Contains(errs, err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// resourceTagChanges returns the tags removed and the tags added or updated between old and new tags, ignoring system tags of the specified service.
+func resourceTagChanges(ctx context.Context, serviceName string, oldTagsMap, newTagsMap any) (KeyValueTags, KeyValueTags) {
+	oldTags := New(ctx, oldTagsMap)
+	newTags := New(ctx, newTagsMap)
+
+	return oldTags.Removed(newTags).IgnoreSystem(serviceName), oldTags.Updated(newTags).IgnoreSystem(serviceName)
+}
+
+// updateResourceTags removes and updates the tags of the resources with the specified ARNs in batches.
+// The returned map contains the error, if any, for each resource. Resources whose tags could not be removed are not tagged.
+func updateResourceTags(ctx context.Context, conn ResourceGroupsTaggingAPIClient, arns []string, removedTags, updatedTags KeyValueTags) map[string]error {
+	failed := make(map[string]error)
+
+	if len(removedTags) > 0 {
+		for _, removedTags := range removedTags.Chunks(untagResourcesMaxKeys) {
+			for arns := range slices.Chunk(arns, tagResourcesMaxARNs) {
+				arns = slices.DeleteFunc(slices.Clone(arns), func(arn string) bool {
+					_, ok := failed[arn]
+					return ok
+				})
+				if len(arns) == 0 {
+					continue
+				}
+
+				input := resourcegroupstaggingapi.UntagResourcesInput{
+					ResourceARNList: arns,
+					TagKeys:         removedTags.Keys(),
+				}
+
+				output, err := conn.UntagResources(ctx, &input)
+
+				if err != nil {
+					err = fmt.Errorf("untagging resources: %w", err)
+					for _, arn := range arns {
+						failed[arn] = err
+					}
+					continue
+				}
+
+				for arn, v := range output.FailedResourcesMap {
+					failed[arn] = fmt.Errorf("untagging resources: %w", failedResourceError(arn, v))
+				}
+			}
+		}
+	}
+
+	if len(updatedTags) > 0 {
+		for _, updatedTags := range updatedTags.Chunks(tagResourcesMaxTags) {
+			for arns := range slices.Chunk(arns, tagResourcesMaxARNs) {
+				arns = slices.DeleteFunc(slices.Clone(arns), func(arn string) bool {
+					_, ok := failed[arn]
+					return ok
+				})
+				if len(arns) == 0 {
+					continue
+				}
+
+				input := resourcegroupstaggingapi.TagResourcesInput{
+					ResourceARNList: arns,
+					Tags:            updatedTags.IgnoreAWS().Map(),
+				}
+
+				output, err := conn.TagResources(ctx, &input)
+
+				if err != nil {
+					err = fmt.Errorf("tagging resources: %w", err)
+					for _, arn := range arns {
+						failed[arn] = err
+					}
+					continue
+				}
+
+				for arn, v := range output.FailedResourcesMap {
+					failed[arn] = fmt.Errorf("tagging resources: %w", failedResourceError(arn, v))
+				}
+			}
+		}
+	}
+
+	return failed
+}
+
+// failedResourceError returns an error for a resource that a TagResources or UntagResources call failed for.
+func failedResourceError(arn string, v awstypes.FailureInfo) error {
+	return fmt.Errorf("%s: %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage))
+}
+
+// ResourceTagsBatcher batches concurrent tag updates of resources using the Resource Groups Tagging API.
+// Updates of resources with the same tag changes, e.g. when the provider's default tags change, are applied together,
+// up to 20 resources per TagResources or UntagResources call.
+type ResourceTagsBatcher struct {
+	conn    ResourceGroupsTaggingAPIClient
+	delay   time.Duration
+	lock    sync.Mutex
+	pending map[string]*resourceTagsBatch // Keyed by service name and tag changes.
+}
+
+type resourceTagsBatch struct {
+	arns   []string
+	done   chan struct{} // Closed when the batch has been applied.
+	failed map[string]error
+	timer  *time.Timer
+}
+
+func NewResourceTagsBatcher(conn ResourceGroupsTaggingAPIClient) *ResourceTagsBatcher {
+	return &ResourceTagsBatcher{
+		conn:    conn,
+		delay:   resourceTagsBatchDelay,
+		pending: make(map[string]*resourceTagsBatch),
+	}
+}
+
+// UpdateTags updates the tags of the resource with the specified ARN, together with any concurrent updates of other resources with the same tag changes.
+// System tags of the specified service are ignored.
+func (b *ResourceTagsBatcher) UpdateTags(ctx context.Context, serviceName, arn string, oldTagsMap, newTagsMap any) error {
+	removedTags, updatedTags := resourceTagChanges(ctx, serviceName, oldTagsMap, newTagsMap)
+	if len(removedTags) == 0 && len(updatedTags) == 0 {
+		return nil
+	}
+
+	key := resourceTagsBatchKey(serviceName, removedTags, updatedTags)
+
+	b.lock.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &resourceTagsBatch{
+			done: make(chan struct{}),
+		}
+		b.pending[key] = batch
+
+		// The batch is applied with the first update's Context, which may be canceled before the other updates complete.
+		ctx := context.WithoutCancel(ctx)
+		batch.timer = time.AfterFunc(b.delay, func() {
+			b.apply(ctx, key, batch, removedTags, updatedTags)
+		})
+	}
+	batch.arns = append(batch.arns, arn)
+	if len(batch.arns) == tagResourcesMaxARNs {
+		// The batch is full, so apply it now unless it is already being applied.
+		delete(b.pending, key)
+		if batch.timer.Stop() {
+			go b.apply(context.WithoutCancel(ctx), key, batch, removedTags, updatedTags)
+		}
+	}
+	b.lock.Unlock()
+
+	select {
+	case <-batch.done:
+		return batch.failed[arn]
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *ResourceTagsBatcher) apply(ctx context.Context, key string, batch *resourceTagsBatch, removedTags, updatedTags KeyValueTags) {
+	// No more updates are added to the batch once it is no longer pending.
+	b.lock.Lock()
+	if b.pending[key] == batch {
+		delete(b.pending, key)
+	}
+	b.lock.Unlock()
+
+	batch.failed = updateResourceTags(ctx, b.conn, batch.arns, removedTags, updatedTags)
+	close(batch.done)
+}
+
+// resourceTagsBatchKey returns a key that is the same for updates with the same tag changes.
+func resourceTagsBatchKey(serviceName string, removedTags, updatedTags KeyValueTags) string {
+	var sb strings.Builder
+
+	sb.WriteString(serviceName)
+	for _, k := range slices.Sorted(maps.Keys(removedTags)) {
+		fmt.Fprintf(&sb, "\x00-%s", k)
+	}
+	m := updatedTags.Map()
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		fmt.Fprintf(&sb, "\x00+%s=%s", k, m[k])
+	}
+
+	return sb.String()
+}
@@ -4,7 +4,15 @@
 package tags
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
 )
 
 func TestGetResourceID(t *testing.T) {
@@ -111,3 +119,206 @@ func TestSetResourceId(t *testing.T) {
 		})
 	}
 }
+
+type mockResourceGroupsTaggingAPIClient struct {
+	failed     map[string]awstypes.FailureInfo
+	lock       sync.Mutex
+	tagCalls   []*resourcegroupstaggingapi.TagResourcesInput
+	untagCalls []*resourcegroupstaggingapi.UntagResourcesInput
+}
+
+func (m *mockResourceGroupsTaggingAPIClient) GetResources(context.Context, *resourcegroupstaggingapi.GetResourcesInput, ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	return &resourcegroupstaggingapi.GetResourcesOutput{}, nil
+}
+
+func (m *mockResourceGroupsTaggingAPIClient) TagResources(_ context.Context, input *resourcegroupstaggingapi.TagResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.TagResourcesOutput, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.tagCalls = append(m.tagCalls, input)
+	return &resourcegroupstaggingapi.TagResourcesOutput{FailedResourcesMap: m.failed}, nil
+}
+
+func (m *mockResourceGroupsTaggingAPIClient) UntagResources(_ context.Context, input *resourcegroupstaggingapi.UntagResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.UntagResourcesOutput, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.untagCalls = append(m.untagCalls, input)
+	return &resourcegroupstaggingapi.UntagResourcesOutput{FailedResourcesMap: m.failed}, nil
+}
+
+func TestUpdateResourceTags(t *testing.T) {
+	t.Parallel()
+
+	arns := func(n int) []string {
+		var arns []string
+		for i := range n {
+			arns = append(arns, fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:queue%d", i)) //lintignore:AWSAT003,AWSAT005
+		}
+		return arns
+	}
+	tags := func(n int) map[string]string {
+		tags := make(map[string]string)
+		for i := range n {
+			tags[fmt.Sprintf("key%d", i)] = "value"
+		}
+		return tags
+	}
+
+	testCases := map[string]struct {
+		arns           []string
+		oldTags        map[string]string
+		newTags        map[string]string
+		failed         map[string]awstypes.FailureInfo
+		wantTagCalls   int
+		wantUntagCalls int
+		wantErr        string
+	}{
+		"no changes": {
+			arns:    arns(5),
+			oldTags: tags(2),
+			newTags: tags(2),
+		},
+		"single batch": {
+			arns:         arns(20),
+			newTags:      tags(50),
+			wantTagCalls: 1,
+		},
+		"batches of ARNs": {
+			arns:         arns(45),
+			newTags:      tags(2),
+			wantTagCalls: 3,
+		},
+		"batches of ARNs and tags": {
+			arns:           arns(45),
+			oldTags:        tags(60),
+			newTags:        map[string]string{"new": "value"},
+			wantTagCalls:   3,
+			wantUntagCalls: 6,
+		},
+		"failed resources": {
+			arns:    arns(2),
+			newTags: tags(1),
+			failed: map[string]awstypes.FailureInfo{
+				"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+					ErrorCode: awstypes.ErrorCodeInvalidParameterException,
+				},
+			},
+			wantTagCalls: 1,
+			wantErr:      "queue1: InvalidParameterException",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &mockResourceGroupsTaggingAPIClient{failed: testCase.failed}
+			err := UpdateResourceTags(t.Context(), conn, "", testCase.arns, testCase.oldTags, testCase.newTags)
+
+			if testCase.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if testCase.wantErr != "" && (err == nil || !strings.Contains(err.Error(), testCase.wantErr)) {
+				t.Fatalf("got error %v, want %q", err, testCase.wantErr)
+			}
+
+			if got, want := len(conn.tagCalls), testCase.wantTagCalls; got != want {
+				t.Errorf("got %d TagResources calls, want %d", got, want)
+			}
+			if got, want := len(conn.untagCalls), testCase.wantUntagCalls; got != want {
+				t.Errorf("got %d UntagResources calls, want %d", got, want)
+			}
+			for _, v := range conn.tagCalls {
+				if len(v.ResourceARNList) > tagResourcesMaxARNs || len(v.Tags) > tagResourcesMaxTags {
+					t.Errorf("TagResources call exceeds quotas: %d ARNs, %d tags", len(v.ResourceARNList), len(v.Tags))
+				}
+			}
+			for _, v := range conn.untagCalls {
+				if len(v.ResourceARNList) > tagResourcesMaxARNs || len(v.TagKeys) > untagResourcesMaxKeys {
+					t.Errorf("UntagResources call exceeds quotas: %d ARNs, %d keys", len(v.ResourceARNList), len(v.TagKeys))
+				}
+			}
+		})
+	}
+}
+
+func TestResourceTagsBatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	failedARN := "arn:aws:sqs:us-west-2:123456789012:queue1" //lintignore:AWSAT003,AWSAT005
+	conn := &mockResourceGroupsTaggingAPIClient{
+		failed: map[string]awstypes.FailureInfo{
+			failedARN: {
+				ErrorCode: awstypes.ErrorCodeInvalidParameterException,
+			},
+		},
+	}
+	batcher := NewResourceTagsBatcher(conn)
+	// Long enough for all the updates to be batched.
+	batcher.delay = time.Second
+
+	type update struct {
+		arn     string
+		oldTags map[string]string
+		newTags map[string]string
+	}
+	var updates []update
+	// A default tag is added to 25 resources.
+	for i := range 25 {
+		updates = append(updates, update{
+			arn:     fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:queue%d", i), //lintignore:AWSAT003,AWSAT005
+			oldTags: map[string]string{"Name": fmt.Sprintf("queue%d", i)},
+			newTags: map[string]string{"Name": fmt.Sprintf("queue%d", i), "Owner": "test"},
+		})
+	}
+	// Another resource's tag changes differently.
+	updates = append(updates, update{
+		arn:     "arn:aws:sqs:us-west-2:123456789012:other", //lintignore:AWSAT003,AWSAT005
+		newTags: map[string]string{"Owner": "other"},
+	})
+	// No changes.
+	updates = append(updates, update{
+		arn:     "arn:aws:sqs:us-west-2:123456789012:unchanged", //lintignore:AWSAT003,AWSAT005
+		oldTags: map[string]string{"Owner": "test"},
+		newTags: map[string]string{"Owner": "test"},
+	})
+
+	errs := make([]error, len(updates))
+	var wg sync.WaitGroup
+	for i, v := range updates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = batcher.UpdateTags(ctx, "", v.arn, v.oldTags, v.newTags)
+		}()
+	}
+	wg.Wait()
+
+	for i, v := range updates {
+		if v.arn == failedARN {
+			if err := errs[i]; err == nil || !strings.Contains(err.Error(), "InvalidParameterException") {
+				t.Errorf("%s: got error %v, want InvalidParameterException", v.arn, err)
+			}
+		} else if err := errs[i]; err != nil {
+			t.Errorf("%s: unexpected error: %s", v.arn, err)
+		}
+	}
+
+	// 20 + 5 resources with the same tag change and 1 resource with a different tag change.
+	if got, want := len(conn.tagCalls), 3; got != want {
+		t.Errorf("got %d TagResources calls, want %d", got, want)
+	}
+	var arns int
+	for _, v := range conn.tagCalls {
+		arns += len(v.ResourceARNList)
+	}
+	if got, want := arns, 26; got != want {
+		t.Errorf("got %d ARNs tagged, want %d", got, want)
+	}
+	if got, want := len(conn.untagCalls), 0; got != want {
+		t.Errorf("got %d UntagResources calls, want %d", got, want)
+	}
+}
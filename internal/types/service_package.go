@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,8 +39,9 @@ func ResourceRegionDisabled() ServicePackageResourceRegion {
 
 // ServicePackageResourceTags represents resource-level tagging information.
 type ServicePackageResourceTags struct {
-	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
-	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	IdentifierAttribute      string // The attribute for the identifier for UpdateTags etc.
+	ResourceType             string // Extra resourceType parameter value for UpdateTags etc.
+	ResourceGroupsTaggingAPI bool   // Whether to use the Resource Groups Tagging API if the service package has no ListTags or UpdateTags method.
 }
 
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
@@ -95,6 +97,35 @@ type ServicePackageSDKResource struct {
 	Import   SDKv2Import
 }
 
+// ServicePackageFrameworkListResource represents a Terraform Plugin Framework list resource
+// implemented by a service package.
+type ServicePackageFrameworkListResource struct {
+	Factory  func() list.ListResourceWithConfigure
+	TypeName string
+	Name     string
+	Tags     unique.Handle[ServicePackageResourceTags]
+	Region   unique.Handle[ServicePackageResourceRegion]
+	Identity Identity
+}
+
+// ServicePackageSDKListResource represents a Terraform Plugin Framework list resource
+// for a Terraform Plugin SDK resource implemented by a service package.
+type ServicePackageSDKListResource struct {
+	Factory  func() ListResourceForSDKTypeName
+	TypeName string
+	Name     string
+	Tags     unique.Handle[ServicePackageResourceTags]
+	Region   unique.Handle[ServicePackageResourceRegion]
+	Identity Identity
+}
+
+// ListResourceForSDKTypeName is the interface implemented by list resources for Terraform Plugin SDK resources.
+// The Terraform Plugin SDK resource's schemas are returned from RawV5Schemas.
+type ListResourceForSDKTypeName interface {
+	list.ListResourceWithConfigure
+	list.ListResourceWithRawV5Schemas
+}
+
 type Identity struct {
 	IsGlobalResource       bool   // All
 	Singleton              bool   // Singleton
@@ -14,8 +14,9 @@ const (
 	envVarVCRMode = "VCR_MODE"
 	envVarVCRPath = "VCR_PATH"
 
-	vcrModeRecordOnly = "RECORD_ONLY"
-	vcrModeReplayOnly = "REPLAY_ONLY"
+	vcrModeRecordIfMissing = "RECORD_IF_MISSING"
+	vcrModeRecordOnly      = "RECORD_ONLY"
+	vcrModeReplayOnly      = "REPLAY_ONLY"
 )
 
 // IsEnabled indicates whether VCR testing is enabled
@@ -27,8 +28,13 @@ func IsEnabled() bool {
 }
 
 // Mode returns the VCR recording mode inferred from the VCR_MODE environment variable
+//
+// RECORD_IF_MISSING replays interactions from an existing cassette and records
+// a new cassette only if none exists.
 func Mode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
+	case vcrModeRecordIfMissing:
+		return recorder.ModeRecordOnce, nil
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
 	case vcrModeReplayOnly:
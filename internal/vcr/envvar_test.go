@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestMode(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	testCases := map[string]struct {
+		value         string
+		expectedMode  recorder.Mode
+		expectedError bool
+	}{
+		"record only": {
+			value:        "RECORD_ONLY",
+			expectedMode: recorder.ModeRecordOnly,
+		},
+		"replay only": {
+			value:        "REPLAY_ONLY",
+			expectedMode: recorder.ModeReplayOnly,
+		},
+		"record if missing": {
+			value:        "RECORD_IF_MISSING",
+			expectedMode: recorder.ModeRecordOnce,
+		},
+		"invalid": {
+			value:         "RECORD_ALWAYS",
+			expectedMode:  recorder.ModePassthrough,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // uses t.Setenv
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envVarVCRMode, testCase.value)
+
+			got, err := Mode()
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("Mode() err %t, want %t", got, want)
+			}
+			if got != testCase.expectedMode {
+				t.Errorf("Mode() = %v, want %v", got, testCase.expectedMode)
+			}
+		})
+	}
+}
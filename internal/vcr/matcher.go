@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// volatileFields are request fields whose values differ on every run (client tokens,
+// timestamps and request signatures) and are ignored when matching requests to
+// recorded interactions. Keys are lower case.
+var volatileFields = map[string]struct{}{
+	"callerreference":      {},
+	"clientrequesttoken":   {},
+	"clienttoken":          {},
+	"idempotencytoken":     {},
+	"timestamp":            {},
+	"x-amz-algorithm":      {},
+	"x-amz-credential":     {},
+	"x-amz-date":           {},
+	"x-amz-expires":        {},
+	"x-amz-security-token": {},
+	"x-amz-signature":      {},
+	"x-amz-signedheaders":  {},
+}
+
+func isVolatileField(name string) bool {
+	_, ok := volatileFields[strings.ToLower(name)]
+	return ok
+}
+
+// RequestMatcher returns a function that matches HTTP requests to recorded interactions
+//
+// Request bodies are compared semantically for the AWS JSON, REST-JSON, REST-XML and
+// query protocols, ignoring field order and volatile fields.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func RequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !urlsMatch(r.URL.String(), i.URL) {
+			return false
+		}
+
+		var body string
+		if r.Body != nil {
+			var b bytes.Buffer
+			if _, err := b.ReadFrom(r.Body); err != nil {
+				tflog.Debug(ctx, "Failed to read request body", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			r.Body = io.NopCloser(&b)
+			body = b.String()
+		}
+
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		match, err := bodiesMatch(r.Header.Get("Content-Type"), body, i.Body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request body with cassette", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return match
+	}
+}
+
+// urlsMatch compares two URLs, ignoring query string order and volatile query string parameters
+func urlsMatch(x, y string) bool {
+	if x == y {
+		return true
+	}
+
+	ux, err := url.Parse(x)
+	if err != nil {
+		return false
+	}
+	uy, err := url.Parse(y)
+	if err != nil {
+		return false
+	}
+
+	if ux.Scheme != uy.Scheme || ux.Host != uy.Host || ux.Path != uy.Path {
+		return false
+	}
+
+	return reflect.DeepEqual(withoutVolatileValues(ux.Query()), withoutVolatileValues(uy.Query()))
+}
+
+// bodiesMatch compares two request bodies of the specified content type
+func bodiesMatch(contentType, x, y string) (bool, error) {
+	if contentType == "" {
+		return false, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false, err
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		var vx, vy any
+
+		if err := json.Unmarshal([]byte(x), &vx); err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal([]byte(y), &vy); err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(withoutVolatileJSON(vx), withoutVolatileJSON(vy)), nil
+
+	case "application/xml", "text/xml":
+		vx, err := parseXML(x)
+		if err != nil {
+			return false, err
+		}
+		vy, err := parseXML(y)
+		if err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(vx, vy), nil
+
+	case "application/x-www-form-urlencoded":
+		vx, err := url.ParseQuery(x)
+		if err != nil {
+			return false, err
+		}
+		vy, err := url.ParseQuery(y)
+		if err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(withoutVolatileValues(vx), withoutVolatileValues(vy)), nil
+	}
+
+	return false, nil
+}
+
+func withoutVolatileValues(v url.Values) url.Values {
+	for k := range v {
+		// Query protocol list and map members are flattened, e.g. "Tags.member.1.Key".
+		if isVolatileField(k[strings.LastIndex(k, ".")+1:]) {
+			delete(v, k)
+		}
+	}
+
+	return v
+}
+
+func withoutVolatileJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isVolatileField(k) {
+				delete(v, k)
+				continue
+			}
+			v[k] = withoutVolatileJSON(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = withoutVolatileJSON(e)
+		}
+	}
+
+	return v
+}
+
+// xmlNode is a generic XML element
+type xmlNode struct {
+	Name     string
+	Attrs    map[string]string
+	Text     string
+	Children []*xmlNode
+}
+
+// parseXML parses an XML document into a tree of xmlNode, omitting volatile elements
+func parseXML(s string) (*xmlNode, error) {
+	root := &xmlNode{}
+	stack := []*xmlNode{root}
+	skip := 0
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if skip > 0 || isVolatileField(token.Name.Local) {
+				skip++
+				continue
+			}
+
+			node := &xmlNode{
+				Name: token.Name.Local,
+			}
+			for _, attr := range token.Attr {
+				if node.Attrs == nil {
+					node.Attrs = make(map[string]string)
+				}
+				node.Attrs[attr.Name.Local] = attr.Value
+			}
+
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if skip > 0 {
+				continue
+			}
+
+			stack[len(stack)-1].Text += strings.TrimSpace(string(token))
+		}
+	}
+
+	return root, nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestRequestMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method      string
+		url         string
+		contentType string
+		body        string
+		recorded    cassette.Request
+		expected    bool
+	}{
+		"identical": {
+			method:      http.MethodPost,
+			url:         "https://sqs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.0",
+			body:        `{"QueueName":"test"}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://sqs.us-west-2.amazonaws.com/",
+				Body:   `{"QueueName":"test"}`,
+			},
+			expected: true,
+		},
+		"different method": {
+			method: http.MethodGet,
+			url:    "https://sqs.us-west-2.amazonaws.com/",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://sqs.us-west-2.amazonaws.com/",
+			},
+			expected: false,
+		},
+		"different URL": {
+			method: http.MethodGet,
+			url:    "https://s3.us-west-2.amazonaws.com/bucket1",
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://s3.us-west-2.amazonaws.com/bucket2",
+			},
+			expected: false,
+		},
+		"URL volatile query parameters": {
+			method: http.MethodGet,
+			url:    "https://s3.us-west-2.amazonaws.com/bucket?list-type=2&X-Amz-Date=20250102T000000Z&X-Amz-Signature=abc",
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://s3.us-west-2.amazonaws.com/bucket?X-Amz-Signature=def&X-Amz-Date=20250101T000000Z&list-type=2",
+			},
+			expected: true,
+		},
+		"JSON reordered": {
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupName":"test","tags":{"a":"1","b":"2"}}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{"tags":{"b":"2","a":"1"},"logGroupName":"test"}`,
+			},
+			expected: true,
+		},
+		"JSON client token": {
+			method:      http.MethodPost,
+			url:         "https://elasticfilesystem.us-west-2.amazonaws.com/2015-02-01/file-systems",
+			contentType: "application/json",
+			body:        `{"CreationToken":"test","Nested":[{"ClientToken":"abc"}],"ClientRequestToken":"123"}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://elasticfilesystem.us-west-2.amazonaws.com/2015-02-01/file-systems",
+				Body:   `{"CreationToken":"test","Nested":[{"ClientToken":"def"}],"ClientRequestToken":"456"}`,
+			},
+			expected: true,
+		},
+		"JSON different": {
+			method:      http.MethodPost,
+			url:         "https://sqs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.0",
+			body:        `{"QueueName":"test1"}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://sqs.us-west-2.amazonaws.com/",
+				Body:   `{"QueueName":"test2"}`,
+			},
+			expected: false,
+		},
+		"XML caller reference": {
+			method:      http.MethodPost,
+			url:         "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			contentType: "application/xml",
+			body:        `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>abc</CallerReference></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body: `<CreateHostedZoneRequest>
+  <Name>example.com</Name>
+  <CallerReference>def</CallerReference>
+</CreateHostedZoneRequest>`,
+			},
+			expected: true,
+		},
+		"XML different": {
+			method:      http.MethodPost,
+			url:         "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			contentType: "application/xml",
+			body:        `<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body:   `<CreateHostedZoneRequest><Name>example.org</Name></CreateHostedZoneRequest>`,
+			},
+			expected: false,
+		},
+		"query reordered": {
+			method:      http.MethodPost,
+			url:         "https://ec2.us-west-2.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&ClientToken=abc&Version=2016-11-15",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/",
+				Body:   "Version=2016-11-15&ClientToken=def&CidrBlock=10.0.0.0%2F16&Action=CreateVpc",
+			},
+			expected: true,
+		},
+		"query different": {
+			method:      http.MethodPost,
+			url:         "https://ec2.us-west-2.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/",
+				Body:   "Action=CreateVpc&CidrBlock=10.1.0.0%2F16&Version=2016-11-15",
+			},
+			expected: false,
+		},
+		"unknown content type": {
+			method:      http.MethodPut,
+			url:         "https://s3.us-west-2.amazonaws.com/bucket/key",
+			contentType: "application/octet-stream",
+			body:        "abc",
+			recorded: cassette.Request{
+				Method: http.MethodPut,
+				URL:    "https://s3.us-west-2.amazonaws.com/bucket/key",
+				Body:   "def",
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequestWithContext(t.Context(), testCase.method, testCase.url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if testCase.contentType != "" {
+				r.Header.Set("Content-Type", testCase.contentType)
+			}
+
+			if got, want := RequestMatcher(t.Context())(r, testCase.recorded), testCase.expected; got != want {
+				t.Errorf("RequestMatcher() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+const (
+	// EnvVarHTTPSProxy is the environment variable used by AWS SDK clients to locate an HTTPS proxy
+	EnvVarHTTPSProxy = "HTTPS_PROXY"
+	// EnvVarCABundle is the environment variable used by AWS SDK clients to locate additional trusted certificates
+	EnvVarCABundle = "AWS_CA_BUNDLE"
+)
+
+var (
+	// interceptedHostSuffixes are the hosts whose traffic is recorded.
+	// All other traffic, e.g. to the Terraform Registry, is tunnelled unmodified.
+	interceptedHostSuffixes = []string{
+		".amazonaws.com",
+		".amazonaws.com.cn",
+		".api.aws",
+	}
+
+	callerIdentityAccountRegexp = regexache.MustCompile(`<GetCallerIdentityResult>[\s\S]*<Account>([0-9]{12})</Account>`)
+)
+
+// Proxy is an HTTPS proxy that sends AWS API requests through a VCR recorder
+//
+// Out-of-process Terraform providers, such as released provider versions used as
+// external providers in acceptance tests, can't have their HTTP client replaced.
+// Configuring them (via the HTTPS_PROXY and AWS_CA_BUNDLE environment variables) to use
+// a Proxy allows their AWS API interactions to be recorded and replayed.
+// TLS connections to AWS endpoints are terminated using certificates issued by a
+// throwaway certificate authority.
+type Proxy struct {
+	caBundle  string
+	caCert    *x509.Certificate
+	caKey     *ecdsa.PrivateKey
+	certs     map[string]*tls.Certificate
+	certsLock sync.Mutex
+	leafKey   *ecdsa.PrivateKey
+	listener  net.Listener
+	server    *http.Server
+	transport http.RoundTripper
+}
+
+// NewProxy starts a Proxy listening on the loopback interface that forwards AWS API
+// requests to the specified transport, typically a *recorder.Recorder
+//
+// The PEM-encoded certificate authority certificate is written to dir.
+func NewProxy(transport http.RoundTripper, dir string) (*Proxy, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating CA key: %w", err)
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating certificate key: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		NotAfter:              now.Add(24 * time.Hour),
+		NotBefore:             now.Add(-time.Hour),
+		SerialNumber:          serialNumber(),
+		Subject: pkix.Name{
+			CommonName:   "Terraform AWS Provider VCR Proxy CA",
+			Organization: []string{"HashiCorp"},
+		},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, caKey.Public(), caKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating CA certificate: %w", err)
+	}
+
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA certificate: %w", err)
+	}
+
+	caBundle := filepath.Join(dir, "vcr-proxy-ca.pem")
+	if err := os.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		return nil, fmt.Errorf("writing CA certificate: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Proxy{
+		caBundle:  caBundle,
+		caCert:    caCert,
+		caKey:     caKey,
+		certs:     make(map[string]*tls.Certificate),
+		leafKey:   leafKey,
+		listener:  listener,
+		transport: transport,
+	}
+	p.server = &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+
+	go p.server.Serve(listener) //nolint:errcheck // Serve always returns a non-nil error on Close
+
+	return p, nil
+}
+
+// URL returns the proxy's URL, suitable for use as the value of HTTPS_PROXY
+func (p *Proxy) URL() string {
+	return "http://" + p.listener.Addr().String()
+}
+
+// CABundle returns the path of the file containing the proxy's certificate authority certificate,
+// suitable for use as the value of AWS_CA_BUNDLE
+func (p *Proxy) CABundle() string {
+	return p.caBundle
+}
+
+// Close stops the proxy, closing all active connections
+func (p *Proxy) Close() error {
+	return p.server.Close()
+}
+
+// ServeHTTP handles HTTP CONNECT requests
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		http.Error(w, "only CONNECT is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+
+	conn, _, err := hijacker.Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer conn.Close()
+
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		return
+	}
+
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host
+	}
+
+	if isInterceptedHost(host) {
+		p.intercept(conn, host)
+	} else {
+		tunnel(conn, r.Host)
+	}
+}
+
+// intercept terminates TLS for the specified host and sends each request on the connection
+// through the proxy's transport
+func (p *Proxy) intercept(conn net.Conn, host string) {
+	tlsConn := tls.Server(conn, &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return p.certificate(host)
+		},
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"http/1.1"},
+	})
+	defer tlsConn.Close()
+
+	reader := bufio.NewReader(tlsConn)
+	for {
+		request, err := http.ReadRequest(reader)
+		if err != nil {
+			return
+		}
+
+		request.RequestURI = ""
+		request.URL.Scheme = "https"
+		request.URL.Host = strings.TrimSuffix(request.Host, ":443")
+		if request.URL.Host == "" {
+			request.URL.Host = host
+		}
+		// Let the transport negotiate compression so that recorded bodies are plain text.
+		request.Header.Del("Accept-Encoding")
+
+		response, err := p.transport.RoundTrip(request)
+		if err != nil {
+			response = &http.Response{
+				Body:       io.NopCloser(strings.NewReader(err.Error())),
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				StatusCode: http.StatusBadGateway,
+			}
+		}
+
+		err = response.Write(tlsConn)
+		response.Body.Close()
+
+		if err != nil || request.Close || response.Close {
+			return
+		}
+	}
+}
+
+// certificate returns a TLS certificate for the specified host issued by the proxy's certificate authority
+func (p *Proxy) certificate(host string) (*tls.Certificate, error) {
+	p.certsLock.Lock()
+	defer p.certsLock.Unlock()
+
+	if cert, ok := p.certs[host]; ok {
+		return cert, nil
+	}
+
+	template := &x509.Certificate{
+		DNSNames:     []string{host},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		NotAfter:     p.caCert.NotAfter,
+		NotBefore:    p.caCert.NotBefore,
+		SerialNumber: serialNumber(),
+		Subject: pkix.Name{
+			CommonName: host,
+		},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, p.caCert, p.leafKey.Public(), p.caKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate for %s: %w", host, err)
+	}
+
+	cert := &tls.Certificate{
+		Certificate: [][]byte{der, p.caCert.Raw},
+		PrivateKey:  p.leafKey,
+	}
+	p.certs[host] = cert
+
+	return cert, nil
+}
+
+// CallerIdentityHook returns a recorder hook that calls f with the AWS account ID
+// returned by STS GetCallerIdentity
+//
+// Use it with RedactHook when the account ID used for recording isn't otherwise known.
+func CallerIdentityHook(f func(accountID string)) recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		if m := callerIdentityAccountRegexp.FindStringSubmatch(i.Response.Body); m != nil {
+			f(m[1])
+		}
+
+		return nil
+	}
+}
+
+func isInterceptedHost(host string) bool {
+	for _, suffix := range interceptedHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tunnel copies data between conn and a connection to the specified address until either is closed
+func tunnel(conn net.Conn, address string) {
+	upstream, err := net.DialTimeout("tcp", address, 30*time.Second)
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	// The first copy to finish closes both connections via the deferred calls, ending the other.
+	done := make(chan struct{}, 2)
+	copyConn := func(dst, src net.Conn) {
+		io.Copy(dst, src) //nolint:errcheck // Errors are expected when either end closes
+		done <- struct{}{}
+	}
+
+	go copyConn(upstream, conn)
+	go copyConn(conn, upstream)
+
+	<-done
+}
+
+func serialNumber() *big.Int {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return big.NewInt(time.Now().UnixNano())
+	}
+
+	return n
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lock sync.Mutex
+		got  []string
+	)
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		lock.Lock()
+		got = append(got, r.Method+" "+r.URL.String()+" "+string(body))
+		lock.Unlock()
+
+		return &http.Response{
+			Body:          io.NopCloser(strings.NewReader("ok")),
+			ContentLength: 2,
+			Header:        http.Header{},
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			StatusCode:    http.StatusOK,
+		}, nil
+	})
+
+	p, err := NewProxy(transport, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		p.Close()
+	})
+
+	pem, err := os.ReadFile(p.CABundle())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		t.Fatal("no certificates in CA bundle")
+	}
+
+	proxyURL, err := url.Parse(p.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyURL(proxyURL),
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				RootCAs:    pool,
+			},
+		},
+	}
+
+	requests := []struct {
+		method, url, body string
+	}{
+		{http.MethodPost, "https://sts.us-west-2.amazonaws.com/", "Action=GetCallerIdentity&Version=2011-06-15"},
+		{http.MethodGet, "https://s3.us-west-2.amazonaws.com/bucket?list-type=2", ""},
+		{http.MethodPost, "https://sts.us-west-2.amazonaws.com/", "Action=GetCallerIdentity&Version=2011-06-15"},
+	}
+	for _, r := range requests {
+		request, err := http.NewRequest(r.method, r.url, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if response.StatusCode != http.StatusOK || string(body) != "ok" {
+			t.Errorf("%s %s: unexpected response %d %q", r.method, r.url, response.StatusCode, body)
+		}
+	}
+
+	want := []string{
+		"POST https://sts.us-west-2.amazonaws.com/ Action=GetCallerIdentity&Version=2011-06-15",
+		"GET https://s3.us-west-2.amazonaws.com/bucket?list-type=2 ",
+		"POST https://sts.us-west-2.amazonaws.com/ Action=GetCallerIdentity&Version=2011-06-15",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestIsInterceptedHost(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"sts.us-west-2.amazonaws.com":          true,
+		"ec2.cn-north-1.amazonaws.com.cn":      true,
+		"ec2.us-east-1.api.aws":                true,
+		"registry.terraform.io":                false,
+		"releases.hashicorp.com":               false,
+		"amazonaws.com.example.com":            false,
+		"objects.githubusercontent.com":        false,
+		"sts-fips.us-gov-west-1.amazonaws.com": true,
+	}
+
+	for host, want := range testCases {
+		if got := isInterceptedHost(host); got != want {
+			t.Errorf("isInterceptedHost(%q) = %t, want %t", host, got, want)
+		}
+	}
+}
+
+func TestCallerIdentityHook(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body string
+		want string
+	}{
+		"GetCallerIdentity": {
+			body: `<GetCallerIdentityResponse><GetCallerIdentityResult>` +
+				`<Arn>arn:aws:iam::111122223333:user/test</Arn><UserId>AIDAEXAMPLE</UserId>` +
+				`<Account>111122223333</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`,
+			want: "111122223333",
+		},
+		"AssumeRole": {
+			body: `<AssumeRoleResponse><AssumeRoleResult><Account>444455556666</Account></AssumeRoleResult></AssumeRoleResponse>`,
+		},
+		"JSON": {
+			body: `{"Account":"111122223333"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			i := &cassette.Interaction{
+				Response: cassette.Response{
+					Body: testCase.body,
+				},
+			}
+
+			if err := CallerIdentityHook(func(accountID string) { got = accountID })(i); err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got account ID %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+const (
+	// RedactedAccountID replaces the AWS account ID in recorded interactions
+	RedactedAccountID = "123456789012"
+
+	redactedValue = "REDACTED"
+)
+
+var (
+	// sensitiveHeaders are removed from recorded requests
+	sensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// checksumHeaders are removed from recorded responses whose body is redacted
+	checksumHeaders = []string{
+		"X-Amz-Crc32",
+	}
+
+	accessKeyIDRegexp  = regexache.MustCompile(`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`)
+	arnAccountIDRegexp = regexache.MustCompile(`(arn:aws[0-9a-z-]*:[0-9a-z-]*:[0-9a-z-]*:)[0-9]{12}(:)`)
+	jsonSecretRegexp   = regexache.MustCompile(`(?i)("(?:secretAccessKey|sessionToken)"\s*:\s*)"[^"]*"`)
+	xmlSecretRegexp    = regexache.MustCompile(`(?i)(<(?:SecretAccessKey|SessionToken)>)[^<]*(</)`)
+)
+
+// SensitiveHeadersHook returns a recorder hook that removes credentials from recorded request headers
+func SensitiveHeadersHook() recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		for _, k := range sensitiveHeaders {
+			delete(i.Request.Headers, k)
+		}
+
+		return nil
+	}
+}
+
+// RedactHook returns a recorder hook that redacts AWS account IDs, access key IDs and
+// secrets in recorded interactions
+//
+// The AWS account ID used for recording is obtained lazily via the accountID function
+// and replaced everywhere with RedactedAccountID.
+// Account IDs in ARNs are always redacted.
+func RedactHook(accountID func() string) recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		r := redactor{}
+		if accountID != nil {
+			r.accountID = accountID()
+		}
+
+		i.Request.URL = r.redact(i.Request.URL)
+		i.Request.Host = r.redact(i.Request.Host)
+		r.redactHeaders(i.Request.Headers)
+		if body := r.redact(i.Request.Body); body != i.Request.Body {
+			i.Request.Body = body
+			i.Request.ContentLength = int64(len(body))
+			i.Request.Form = nil
+		}
+
+		r.redactHeaders(i.Response.Headers)
+		if body := r.redact(i.Response.Body); body != i.Response.Body {
+			i.Response.Body = body
+			if i.Response.ContentLength >= 0 {
+				i.Response.ContentLength = int64(len(body))
+			}
+			if i.Response.Headers.Get("Content-Length") != "" {
+				i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+			}
+			for _, k := range checksumHeaders {
+				i.Response.Headers.Del(k)
+			}
+		}
+
+		return nil
+	}
+}
+
+type redactor struct {
+	accountID string
+}
+
+func (r redactor) redact(s string) string {
+	if s == "" {
+		return s
+	}
+
+	if r.accountID != "" && r.accountID != RedactedAccountID {
+		s = strings.ReplaceAll(s, r.accountID, RedactedAccountID)
+	}
+
+	s = arnAccountIDRegexp.ReplaceAllString(s, "${1}"+RedactedAccountID+"${2}")
+	s = accessKeyIDRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		return v[:4] + strings.Repeat("X", len(v)-4)
+	})
+	s = jsonSecretRegexp.ReplaceAllString(s, `${1}"`+redactedValue+`"`)
+	s = xmlSecretRegexp.ReplaceAllString(s, "${1}"+redactedValue+"${2}")
+
+	return s
+}
+
+func (r redactor) redactHeaders(h http.Header) {
+	for _, v := range h {
+		for i := range v {
+			v[i] = r.redact(v[i])
+		}
+	}
+}
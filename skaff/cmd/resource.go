@@ -15,13 +15,14 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	fromSDK       string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, fromSDK, !clearComments, force, !pluginSDKV2, includeTags)
 	},
 }
 
@@ -33,4 +34,5 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&fromSDK, "from-sdk", "", "generate a complete Terraform Plugin Framework resource from the AWS SDK for Go v2 API model of a create operation (e.g., mediaconnect.CreateFlow)")
 }
@@ -8,12 +8,14 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
 
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/sdkmodel"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
@@ -46,9 +48,20 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+
+	// Set when generating from an AWS SDK for Go v2 API model.
+	SDK               *sdkmodel.Resource
+	CreateName        string   // Go expression identifying the resource in create errors.
+	Imports           []string // Import declarations required by the schema and models.
+	NotFoundException string   // Error type returned when the resource is not found.
+
+	attrConstants map[string]string
 }
 
-func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+// Create creates a resource, its acceptance tests and website documentation.
+// If fromSDK is set, e.g. "mediaconnect.CreateFlow", the resource is generated from the AWS SDK for Go v2 API model of
+// the specified create operation.
+func Create(resName, snakeName, fromSDK string, comments, force, pluginFramework, tags bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -56,11 +69,15 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 
 	servicePackage := filepath.Base(wd)
 
-	if resName == "" {
+	if resName == "" && fromSDK == "" {
 		return fmt.Errorf("error checking: no name given")
 	}
 
-	if resName == strings.ToLower(resName) {
+	if fromSDK != "" && !pluginFramework {
+		return fmt.Errorf("error checking: resources generated from an AWS SDK API model use Terraform Plugin Framework")
+	}
+
+	if resName != "" && resName == strings.ToLower(resName) {
 		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
@@ -68,15 +85,26 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
-	if snakeName == "" {
-		snakeName = names.ToSnakeCase(resName)
-	}
-
 	service, err := data.LookupService(servicePackage)
 	if err != nil {
 		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
 	}
 
+	var model *sdkmodel.Resource
+	if fromSDK != "" {
+		model, err = loadSDKModel(fromSDK, service.GoV2Package(), resName)
+		if err != nil {
+			return err
+		}
+
+		resName = model.Name
+		tags = model.Tags
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
 	templateData := TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
@@ -98,6 +126,25 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
 	}
+
+	if model != nil {
+		templateData.SDK = model
+		templateData.NotFoundException = notFoundException(model.SDKPackage)
+		templateData.CreateName = `""`
+		for _, v := range model.Model.Fields {
+			if v.Name == "Name" && (v.Required || v.Optional) {
+				templateData.CreateName = "plan.Name.String()"
+			}
+		}
+		for _, v := range model.Imports {
+			templateData.Imports = append(templateData.Imports, importSpec(v))
+		}
+		if templateData.attrConstants, err = attrConstants(); err != nil {
+			return err
+		}
+
+		tmpl = resourceFrameworkSDKTmpl
+	}
 	f := fmt.Sprintf("%s.go", snakeName)
 	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
@@ -114,6 +161,12 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
+	if model != nil && model.ListOperation != "" {
+		if err := registerSweeper(servicePackage, templateData.ProviderResourceName, fmt.Sprintf("sweep%ss", resName)); err != nil {
+			return fmt.Errorf("registering resource sweeper: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -127,7 +180,7 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
-	tplate, err := template.New(templateName).Parse(tmpl)
+	tplate, err := template.New(templateName).Funcs(td.templateFuncs()).Parse(tmpl)
 	if err != nil {
 		return fmt.Errorf("error parsing template: %s", err)
 	}
@@ -138,13 +191,16 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	contents := buffer.Bytes()
+	if td.SDK != nil && filepath.Ext(filename) == ".go" {
+		// Resources generated from an API model are complete, so are formatted.
+		if contents, err = format.Source(contents); err != nil {
+			f.Close() // ignore error; formatting error takes precedence
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/sdkmodel"
+)
+
+//go:embed resourcefwsdk.gtpl
+var resourceFrameworkSDKTmpl string
+
+//go:embed sdkmodel.gtpl
+var sdkModelTmpl string
+
+//go:embed sweep.gtpl
+var sweepTmpl string
+
+// providerRoot is the provider module's root directory, relative to a service package directory.
+var providerRoot = filepath.Join("..", "..", "..")
+
+// loadSDKModel returns the model of the resource created by an AWS SDK for Go v2 operation, e.g. "mediaconnect.CreateFlow".
+// The model is built by a temporary program in the provider module, as only the provider module depends on all the service clients.
+func loadSDKModel(operation, sdkPackage, resName string) (*sdkmodel.Resource, error) {
+	pkg, op, ok := strings.Cut(operation, ".")
+	if !ok || pkg == "" || op == "" {
+		return nil, fmt.Errorf("error checking: operation (%s) should be of the form <service>.<Operation> (e.g., %s.Create%s)", operation, sdkPackage, resName)
+	}
+
+	if pkg != sdkPackage {
+		return nil, fmt.Errorf("error checking: operation (%s) is not in the service's AWS SDK for Go v2 package (%s)", operation, sdkPackage)
+	}
+
+	if _, err := os.Stat(filepath.Join(providerRoot, "go.mod")); err != nil {
+		return nil, fmt.Errorf("error checking: skaff must be run in a service package directory (e.g., internal/service/%s): %w", sdkPackage, err)
+	}
+
+	// Directories beginning with "_" are ignored by "./..." patterns.
+	dir, err := os.MkdirTemp(providerRoot, "_skaff")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tmpl, err := template.New("sdkmodel").Parse(sdkModelTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, map[string]string{
+		"Name":       resName,
+		"Operation":  op,
+		"SDKPackage": sdkPackage,
+	}); err != nil {
+		return nil, fmt.Errorf("error executing template: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), buffer.Bytes(), 0644); err != nil {
+		return nil, fmt.Errorf("error writing file: %w", err)
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", "run", "./"+filepath.Base(dir))
+	cmd.Dir = providerRoot
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error building model of %s: %w", operation, err)
+	}
+
+	var r sdkmodel.Resource
+	if err := json.Unmarshal(stdout.Bytes(), &r); err != nil {
+		return nil, fmt.Errorf("error reading model of %s: %w", operation, err)
+	}
+
+	return &r, nil
+}
+
+// notFoundExceptionRegexp matches the declarations of an AWS SDK for Go v2 service's "not found" errors.
+var notFoundExceptionRegexp = regexp.MustCompile(`(?m)^type (\w*NotFound\w*) struct`)
+
+// notFoundException returns the name of the error type returned by a service when a resource is not found.
+// The service's error types are read from the AWS SDK for Go v2 module source.
+func notFoundException(sdkPackage string) string {
+	const defaultException = "ResourceNotFoundException"
+
+	out, err := exec.Command("go", "list", "-C", providerRoot, "-m", "-f", "{{ .Dir }}", "github.com/aws/aws-sdk-go-v2/service/"+sdkPackage).Output()
+	if err != nil {
+		return defaultException
+	}
+
+	b, err := os.ReadFile(filepath.Join(strings.TrimSpace(string(out)), "types", "errors.go"))
+	if err != nil {
+		return defaultException
+	}
+
+	var exceptions []string
+	for _, m := range notFoundExceptionRegexp.FindAllStringSubmatch(string(b), -1) {
+		exceptions = append(exceptions, m[1])
+	}
+
+	for _, v := range []string{defaultException, "NotFoundException"} {
+		if slices.Contains(exceptions, v) {
+			return v
+		}
+	}
+	if len(exceptions) > 0 {
+		return exceptions[0]
+	}
+
+	return defaultException
+}
+
+// attrConstants returns the names of the attribute name constants in the names package, keyed by attribute name.
+func attrConstants() (map[string]string, error) {
+	f, err := os.Open(filepath.Join(providerRoot, "names", "attr_constants.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("error opening attribute constants: %w", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading attribute constants: %w", err)
+	}
+
+	constants := make(map[string]string, len(records))
+	for _, v := range records {
+		constants[v[0]] = "Attr" + v[1]
+	}
+
+	return constants, nil
+}
+
+// templateFuncs returns the functions used by the templates of resources generated from an AWS SDK for Go v2 API model.
+func (td TemplateData) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// attr returns the Go expression for an attribute name, preferring a names package constant.
+		"attr": func(name string) string {
+			if v, ok := td.attrConstants[name]; ok {
+				return "names." + v
+			}
+			return fmt.Sprintf("%q", name)
+		},
+		"hasAttributes": func(fields []*sdkmodel.Field) bool {
+			return slices.ContainsFunc(fields, func(f *sdkmodel.Field) bool { return !f.Block })
+		},
+		"hasBlocks": func(fields []*sdkmodel.Field) bool {
+			return slices.ContainsFunc(fields, func(f *sdkmodel.Field) bool { return f.Block })
+		},
+		"lower": strings.ToLower,
+	}
+}
+
+// importSpec returns the import declaration for a package imported by a generated resource.
+func importSpec(path string) string {
+	switch {
+	case path == "github.com/hashicorp/terraform-provider-aws/internal/framework/types":
+		return `fwtypes "` + path + `"`
+	case strings.HasPrefix(path, "github.com/aws/aws-sdk-go-v2/service/") && strings.HasSuffix(path, "/types"):
+		return `awstypes "` + path + `"`
+	}
+
+	return `"` + path + `"`
+}
+
+// registerSweeper adds the registration of a resource's sweeper to the service package's RegisterSweepers function,
+// creating sweep.go if it does not exist.
+func registerSweeper(servicePackage, providerResourceName, sweeper string) error {
+	const filename = "sweep.go"
+
+	registration := fmt.Sprintf("\tawsv2.Register(%q, %s)\n", providerResourceName, sweeper)
+
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		tmpl, err := template.New("sweep").Parse(sweepTmpl)
+		if err != nil {
+			return fmt.Errorf("error parsing template: %w", err)
+		}
+
+		var buffer bytes.Buffer
+		if err := tmpl.Execute(&buffer, map[string]string{
+			"Registration":   registration,
+			"ServicePackage": servicePackage,
+		}); err != nil {
+			return fmt.Errorf("error executing template: %w", err)
+		}
+
+		return os.WriteFile(filename, buffer.Bytes(), 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %w", filename, err)
+	}
+
+	s := string(b)
+	if strings.Contains(s, registration) {
+		return nil
+	}
+
+	const fn = "func RegisterSweepers() {\n"
+	start := strings.Index(s, fn)
+	if start == -1 {
+		return fmt.Errorf("no RegisterSweepers function found in %s", filename)
+	}
+	end := strings.Index(s[start:], "\n}\n")
+	if end == -1 {
+		return fmt.Errorf("end of RegisterSweepers function not found in %s", filename)
+	}
+	end += start + 1
+
+	s = s[:end] + "\n" + registration + s[end:]
+
+	return os.WriteFile(filename, []byte(s), 0644)
+}
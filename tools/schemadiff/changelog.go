@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Changelog returns a summary of the report's changes in the style of the provider's CHANGELOG.md.
+func (r *Report) Changelog() string {
+	var breaking, features, enhancements, notes []string
+
+	for _, c := range r.Changes {
+		if c.Type == ChangeTypeAdded {
+			features = append(features, fmt.Sprintf("* **New %s:** `%s`", c.Kind.title(), c.Name))
+			continue
+		}
+
+		entry := fmt.Sprintf("* %s/%s: %s", c.Kind, c.Name, c.summary())
+
+		switch {
+		case c.Breaking:
+			breaking = append(breaking, entry)
+		case c.Type == ChangeTypeDeprecated || c.Type == ChangeTypeNowSensitive:
+			notes = append(notes, entry)
+		default:
+			enhancements = append(enhancements, entry)
+		}
+	}
+
+	var sb strings.Builder
+
+	if r.OldRevision != "" || r.NewRevision != "" {
+		fmt.Fprintf(&sb, "## %s...%s\n\n", r.OldRevision, r.NewRevision)
+	}
+
+	for _, section := range []struct {
+		heading string
+		entries []string
+	}{
+		{"BREAKING CHANGES", breaking},
+		{"NOTES", notes},
+		{"FEATURES", features},
+		{"ENHANCEMENTS", enhancements},
+	} {
+		if len(section.entries) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "%s:\n\n", section.heading)
+		for _, entry := range section.entries {
+			sb.WriteString(entry + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	if sb.Len() == 0 {
+		sb.WriteString("No schema changes.\n")
+	}
+
+	return sb.String()
+}
+
+func (k Kind) title() string {
+	switch k {
+	case KindResource:
+		return "Resource"
+	case KindDataSource:
+		return "Data Source"
+	case KindEphemeralResource:
+		return "Ephemeral Resource"
+	case KindFunction:
+		return "Function"
+	}
+
+	return string(k)
+}
+
+func (c *Change) summary() string {
+	path := "`" + c.Path + "`"
+
+	switch c.Type {
+	case ChangeTypeRemoved:
+		return fmt.Sprintf("%s has been removed", c.Kind.title())
+	case ChangeTypeOptionalAttributeAdded:
+		return fmt.Sprintf("Add %s argument", path)
+	case ChangeTypeRequiredAttributeAdded:
+		return fmt.Sprintf("Add required %s argument", path)
+	case ChangeTypeComputedAttributeAdded:
+		return fmt.Sprintf("Add %s attribute", path)
+	case ChangeTypeAttributeRemoved:
+		return fmt.Sprintf("Remove %s", path)
+	case ChangeTypeBlockAdded:
+		return fmt.Sprintf("Add %s configuration block", path)
+	case ChangeTypeRequiredBlockAdded:
+		return fmt.Sprintf("Add required %s configuration block", path)
+	case ChangeTypeBlockRemoved:
+		return fmt.Sprintf("Remove %s configuration block", path)
+	case ChangeTypeForceNewAdded:
+		return fmt.Sprintf("Changing %s now forces replacement", path)
+	case ChangeTypeForceNewRemoved:
+		return fmt.Sprintf("%s can now be updated in-place", path)
+	case ChangeTypeTypeChanged:
+		return fmt.Sprintf("The type of %s has changed from `%s` to `%s`", path, c.Old, c.New)
+	case ChangeTypeNestingChanged:
+		return fmt.Sprintf("%s is now a %s nested block instead of a %s nested block", path, c.New, c.Old)
+	case ChangeTypeDefaultChanged:
+		return fmt.Sprintf("The default value of %s has changed from `%s` to `%s`", path, valueOrNone(c.Old), valueOrNone(c.New))
+	case ChangeTypeNowRequired:
+		return fmt.Sprintf("%s is now required", path)
+	case ChangeTypeNowOptional:
+		return fmt.Sprintf("%s is now optional", path)
+	case ChangeTypeNoLongerComputed:
+		return fmt.Sprintf("%s is no longer computed", path)
+	case ChangeTypeMaxItemsDecreased, ChangeTypeMaxItemsIncreased:
+		return fmt.Sprintf("The maximum number of %s blocks has changed from %s to %s", path, limitOrUnlimited(c.Old), limitOrUnlimited(c.New))
+	case ChangeTypeMinItemsIncreased, ChangeTypeMinItemsDecreased:
+		return fmt.Sprintf("The minimum number of %s blocks has changed from %s to %s", path, valueOrNone(c.Old), valueOrNone(c.New))
+	case ChangeTypeDeprecated:
+		return fmt.Sprintf("%s has been deprecated", path)
+	case ChangeTypeNowSensitive:
+		return fmt.Sprintf("%s is now sensitive", path)
+	case ChangeTypeSignatureChanged:
+		return fmt.Sprintf("The parameters have changed from `%s` to `%s`", c.Old, c.New)
+	case ChangeTypeFunctionReturnChanged:
+		return fmt.Sprintf("The return type has changed from `%s` to `%s`", c.Old, c.New)
+	}
+
+	return string(c.Type)
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+
+	return s
+}
+
+func limitOrUnlimited(s string) string {
+	if s == "" {
+		return "unlimited"
+	}
+
+	return s
+}
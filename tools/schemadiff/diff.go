@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Kind is the kind of provider object that changed.
+type Kind string
+
+const (
+	KindResource          Kind = "resource"
+	KindDataSource        Kind = "data-source"
+	KindEphemeralResource Kind = "ephemeral"
+	KindFunction          Kind = "function"
+)
+
+// ChangeType classifies a single schema change.
+type ChangeType string
+
+const (
+	ChangeTypeAdded                  ChangeType = "added"
+	ChangeTypeRemoved                ChangeType = "removed"
+	ChangeTypeOptionalAttributeAdded ChangeType = "optional_attribute_added"
+	ChangeTypeRequiredAttributeAdded ChangeType = "required_attribute_added"
+	ChangeTypeComputedAttributeAdded ChangeType = "computed_attribute_added"
+	ChangeTypeAttributeRemoved       ChangeType = "attribute_removed"
+	ChangeTypeBlockAdded             ChangeType = "block_added"
+	ChangeTypeRequiredBlockAdded     ChangeType = "required_block_added"
+	ChangeTypeBlockRemoved           ChangeType = "block_removed"
+	ChangeTypeForceNewAdded          ChangeType = "force_new_added"
+	ChangeTypeForceNewRemoved        ChangeType = "force_new_removed"
+	ChangeTypeTypeChanged            ChangeType = "type_changed"
+	ChangeTypeNestingChanged         ChangeType = "nesting_changed"
+	ChangeTypeDefaultChanged         ChangeType = "default_changed"
+	ChangeTypeNowRequired            ChangeType = "now_required"
+	ChangeTypeNowOptional            ChangeType = "now_optional"
+	ChangeTypeNoLongerComputed       ChangeType = "no_longer_computed"
+	ChangeTypeMaxItemsDecreased      ChangeType = "max_items_decreased"
+	ChangeTypeMaxItemsIncreased      ChangeType = "max_items_increased"
+	ChangeTypeMinItemsIncreased      ChangeType = "min_items_increased"
+	ChangeTypeMinItemsDecreased      ChangeType = "min_items_decreased"
+	ChangeTypeDeprecated             ChangeType = "deprecated"
+	ChangeTypeNowSensitive           ChangeType = "now_sensitive"
+	ChangeTypeSignatureChanged       ChangeType = "signature_changed"
+	ChangeTypeFunctionReturnChanged  ChangeType = "return_type_changed"
+)
+
+// Change is a single difference between two provider schemas.
+type Change struct {
+	Kind Kind   `json:"kind"`
+	Name string `json:"name"`
+	// Path is the dotted path to the changed attribute or block, empty if the change applies to the whole object.
+	Path     string     `json:"path,omitempty"`
+	Type     ChangeType `json:"type"`
+	Breaking bool       `json:"breaking"`
+	Old      string     `json:"old,omitempty"`
+	New      string     `json:"new,omitempty"`
+}
+
+// Report is the result of diffing two provider schemas.
+type Report struct {
+	OldRevision string    `json:"old_revision,omitempty"`
+	NewRevision string    `json:"new_revision,omitempty"`
+	Changes     []*Change `json:"changes"`
+}
+
+// HasBreakingChanges returns whether any change in the report is breaking.
+func (r *Report) HasBreakingChanges() bool {
+	return slices.ContainsFunc(r.Changes, func(c *Change) bool {
+		return c.Breaking
+	})
+}
+
+func diff(old, new *ProviderSchemas) *Report {
+	d := &differ{}
+
+	d.blocks(KindResource, old.Resources, new.Resources)
+	d.blocks(KindDataSource, old.DataSources, new.DataSources)
+	d.blocks(KindEphemeralResource, old.EphemeralResources, new.EphemeralResources)
+	d.functions(old.Functions, new.Functions)
+
+	return &Report{
+		OldRevision: old.Revision,
+		NewRevision: new.Revision,
+		Changes:     d.changes,
+	}
+}
+
+type differ struct {
+	changes []*Change
+}
+
+func (d *differ) add(change *Change) {
+	d.changes = append(d.changes, change)
+}
+
+func (d *differ) blocks(kind Kind, old, new map[string]*Block) {
+	for _, name := range sortedUnion(old, new) {
+		o, n := old[name], new[name]
+
+		switch {
+		case o == nil:
+			d.add(&Change{Kind: kind, Name: name, Type: ChangeTypeAdded})
+		case n == nil:
+			d.add(&Change{Kind: kind, Name: name, Type: ChangeTypeRemoved, Breaking: true})
+		default:
+			d.block(kind, name, nil, o, n)
+		}
+	}
+}
+
+func (d *differ) block(kind Kind, name string, path []string, old, new *Block) {
+	for _, k := range sortedUnion(old.Attributes, new.Attributes) {
+		o, n := old.Attributes[k], new.Attributes[k]
+		path := append(slices.Clone(path), k)
+
+		switch {
+		case o == nil:
+			change := &Change{Kind: kind, Name: name, Path: joinPath(path), Type: ChangeTypeOptionalAttributeAdded, New: n.Type}
+			switch {
+			case n.Required:
+				change.Type, change.Breaking = ChangeTypeRequiredAttributeAdded, true
+			case !n.Optional:
+				change.Type = ChangeTypeComputedAttributeAdded
+			}
+			d.add(change)
+		case n == nil:
+			d.add(&Change{Kind: kind, Name: name, Path: joinPath(path), Type: ChangeTypeAttributeRemoved, Breaking: true, Old: o.Type})
+		default:
+			d.attribute(kind, name, path, o, n)
+		}
+	}
+
+	for _, k := range sortedUnion(old.Blocks, new.Blocks) {
+		o, n := old.Blocks[k], new.Blocks[k]
+		path := append(slices.Clone(path), k)
+
+		switch {
+		case o == nil:
+			change := &Change{Kind: kind, Name: name, Path: joinPath(path), Type: ChangeTypeBlockAdded, New: n.Nesting}
+			if n.MinItems > 0 {
+				change.Type, change.Breaking = ChangeTypeRequiredBlockAdded, true
+			}
+			d.add(change)
+		case n == nil:
+			d.add(&Change{Kind: kind, Name: name, Path: joinPath(path), Type: ChangeTypeBlockRemoved, Breaking: true, Old: o.Nesting})
+		default:
+			d.nestedBlock(kind, name, path, o, n)
+		}
+	}
+}
+
+func (d *differ) attribute(kind Kind, name string, path []string, old, new *Attribute) {
+	newChange := func(changeType ChangeType, breaking bool, o, n string) {
+		d.add(&Change{Kind: kind, Name: name, Path: joinPath(path), Type: changeType, Breaking: breaking, Old: o, New: n})
+	}
+
+	if old.Type != new.Type {
+		newChange(ChangeTypeTypeChanged, true, old.Type, new.Type)
+	}
+
+	switch {
+	case !old.Required && new.Required:
+		newChange(ChangeTypeNowRequired, true, "", "")
+	case old.Required && !new.Required:
+		newChange(ChangeTypeNowOptional, false, "", "")
+	}
+
+	// An argument that is no longer computed shows a diff wherever it was previously unset in configuration.
+	if old.Computed && !new.Computed && (old.Optional || new.Optional) {
+		newChange(ChangeTypeNoLongerComputed, true, "", "")
+	}
+
+	// Data sources and ephemeral resources are never replaced.
+	if kind == KindResource {
+		switch {
+		case !old.ForceNew && new.ForceNew:
+			newChange(ChangeTypeForceNewAdded, true, "", "")
+		case old.ForceNew && !new.ForceNew:
+			newChange(ChangeTypeForceNewRemoved, false, "", "")
+		}
+	}
+
+	if old.Default != new.Default {
+		newChange(ChangeTypeDefaultChanged, true, old.Default, new.Default)
+	}
+
+	if !old.Deprecated && new.Deprecated {
+		newChange(ChangeTypeDeprecated, false, "", "")
+	}
+
+	if !old.Sensitive && new.Sensitive {
+		newChange(ChangeTypeNowSensitive, false, "", "")
+	}
+}
+
+func (d *differ) nestedBlock(kind Kind, name string, path []string, old, new *NestedBlock) {
+	newChange := func(changeType ChangeType, breaking bool, o, n string) {
+		d.add(&Change{Kind: kind, Name: name, Path: joinPath(path), Type: changeType, Breaking: breaking, Old: o, New: n})
+	}
+
+	if old.Nesting != new.Nesting {
+		newChange(ChangeTypeNestingChanged, true, old.Nesting, new.Nesting)
+	}
+
+	// A MaxItems of 0 means unlimited.
+	switch o, n := old.MaxItems, new.MaxItems; {
+	case o == n:
+	case o == 0 || (n != 0 && n < o):
+		newChange(ChangeTypeMaxItemsDecreased, true, itemCount(o), itemCount(n))
+	default:
+		newChange(ChangeTypeMaxItemsIncreased, false, itemCount(o), itemCount(n))
+	}
+
+	switch o, n := old.MinItems, new.MinItems; {
+	case n > o:
+		newChange(ChangeTypeMinItemsIncreased, true, itemCount(o), itemCount(n))
+	case n < o:
+		newChange(ChangeTypeMinItemsDecreased, false, itemCount(o), itemCount(n))
+	}
+
+	if kind == KindResource {
+		switch {
+		case !old.ForceNew && new.ForceNew:
+			newChange(ChangeTypeForceNewAdded, true, "", "")
+		case old.ForceNew && !new.ForceNew:
+			newChange(ChangeTypeForceNewRemoved, false, "", "")
+		}
+	}
+
+	if !old.Deprecated && new.Deprecated {
+		newChange(ChangeTypeDeprecated, false, "", "")
+	}
+
+	d.block(kind, name, path, &old.Block, &new.Block)
+}
+
+func (d *differ) functions(old, new map[string]*Function) {
+	for _, name := range sortedUnion(old, new) {
+		o, n := old[name], new[name]
+
+		switch {
+		case o == nil:
+			d.add(&Change{Kind: KindFunction, Name: name, Type: ChangeTypeAdded})
+		case n == nil:
+			d.add(&Change{Kind: KindFunction, Name: name, Type: ChangeTypeRemoved, Breaking: true})
+		default:
+			if o, n := o.signature(), n.signature(); o != n {
+				d.add(&Change{Kind: KindFunction, Name: name, Type: ChangeTypeSignatureChanged, Breaking: true, Old: o, New: n})
+			}
+			if o.Return != n.Return {
+				d.add(&Change{Kind: KindFunction, Name: name, Type: ChangeTypeFunctionReturnChanged, Breaking: true, Old: o.Return, New: n.Return})
+			}
+		}
+	}
+}
+
+// signature returns the function's parameter types, ignoring parameter names which are not part of the Terraform language.
+func (f *Function) signature() string {
+	types := make([]string, 0, len(f.Parameters)+1)
+	for _, v := range f.Parameters {
+		types = append(types, v.Type)
+	}
+	if v := f.VariadicParameter; v != nil {
+		types = append(types, "..."+v.Type)
+	}
+
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+func itemCount(n int64) string {
+	if n == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", n)
+}
+
+func joinPath(path []string) string {
+	return strings.Join(path, ".")
+}
+
+func sortedUnion[V any](a, b map[string]V) []string {
+	keys := slices.Collect(maps.Keys(a))
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
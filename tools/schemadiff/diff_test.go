@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	old := &ProviderSchemas{
+		Resources: map[string]*Block{
+			"aws_example": {
+				Attributes: map[string]*Attribute{
+					"id":             {Type: "string", Computed: true},
+					"name":           {Type: "string", Required: true},
+					"description":    {Type: "string", Optional: true},
+					"size":           {Type: "number", Optional: true, Default: "10"},
+					"legacy":         {Type: "string", Optional: true},
+					"tags":           {Type: "map(string)", Optional: true},
+					"security_group": {Type: "string", Optional: true},
+				},
+				Blocks: map[string]*NestedBlock{
+					"rule": {
+						Nesting: "list",
+						Block: Block{
+							Attributes: map[string]*Attribute{
+								"port": {Type: "number", Optional: true},
+							},
+						},
+					},
+				},
+			},
+			"aws_removed": {},
+		},
+		DataSources: map[string]*Block{},
+		Functions: map[string]*Function{
+			"arn_parse": {Parameters: []*FunctionParameter{{Name: "arn", Type: "string"}}, Return: "object({})"},
+		},
+	}
+	new := &ProviderSchemas{
+		Resources: map[string]*Block{
+			"aws_example": {
+				Attributes: map[string]*Attribute{
+					"id":             {Type: "string", Computed: true},
+					"name":           {Type: "string", Required: true, ForceNew: true},
+					"description":    {Type: "string", Optional: true},
+					"size":           {Type: "number", Optional: true, Default: "20"},
+					"tags":           {Type: "map(string)", Optional: true},
+					"security_group": {Type: "set(string)", Optional: true},
+					"encrypted":      {Type: "bool", Optional: true},
+				},
+				Blocks: map[string]*NestedBlock{
+					"rule": {
+						Nesting: "list",
+						Block: Block{
+							Attributes: map[string]*Attribute{
+								"port":     {Type: "number", Optional: true},
+								"protocol": {Type: "string", Required: true},
+							},
+						},
+					},
+				},
+			},
+		},
+		DataSources: map[string]*Block{
+			"aws_example": {},
+		},
+		Functions: map[string]*Function{
+			"arn_parse": {Parameters: []*FunctionParameter{{Name: "value", Type: "string"}}, Return: "object({})"},
+		},
+	}
+
+	report := diff(old, new)
+
+	type key struct {
+		kind       Kind
+		name, path string
+		changeType ChangeType
+		breaking   bool
+	}
+	want := []key{
+		{KindResource, "aws_example", "encrypted", ChangeTypeOptionalAttributeAdded, false},
+		{KindResource, "aws_example", "legacy", ChangeTypeAttributeRemoved, true},
+		{KindResource, "aws_example", "name", ChangeTypeForceNewAdded, true},
+		{KindResource, "aws_example", "security_group", ChangeTypeTypeChanged, true},
+		{KindResource, "aws_example", "size", ChangeTypeDefaultChanged, true},
+		{KindResource, "aws_example", "rule.protocol", ChangeTypeRequiredAttributeAdded, true},
+		{KindResource, "aws_removed", "", ChangeTypeRemoved, true},
+		{KindDataSource, "aws_example", "", ChangeTypeAdded, false},
+	}
+
+	var got []key
+	for _, c := range report.Changes {
+		got = append(got, key{c.Kind, c.Name, c.Path, c.Type, c.Breaking})
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d changes %v, want %d changes %v", len(got), got, len(want), want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if !report.HasBreakingChanges() {
+		t.Error("expected breaking changes")
+	}
+}
+
+func TestChangelog(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		Changes: []*Change{
+			{Kind: KindResource, Name: "aws_example", Path: "encrypted", Type: ChangeTypeOptionalAttributeAdded, New: "bool"},
+			{Kind: KindResource, Name: "aws_example", Path: "legacy", Type: ChangeTypeAttributeRemoved, Breaking: true, Old: "string"},
+			{Kind: KindDataSource, Name: "aws_example", Type: ChangeTypeAdded},
+		},
+	}
+
+	got := report.Changelog()
+	want := strings.Join([]string{
+		"BREAKING CHANGES:",
+		"",
+		"* resource/aws_example: Remove `legacy`",
+		"",
+		"FEATURES:",
+		"",
+		"* **New Data Source:** `aws_example`",
+		"",
+		"ENHANCEMENTS:",
+		"",
+		"* resource/aws_example: Add `encrypted` argument",
+		"",
+		"",
+	}, "\n")
+
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestChangelogNoChanges(t *testing.T) {
+	t.Parallel()
+
+	if got, want := (&Report{}).Changelog(), "No schema changes.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
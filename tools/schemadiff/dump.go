@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build schemadiffdump
+
+// This file is not compiled as part of schemadiff; it is embedded in the schemadiff binary.
+// It is copied, together with schema.go, into a checkout of the provider revision being examined and run there
+// so that the revision's own dependencies are used to build the provider.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
+)
+
+// frameworkRequiresReplaceDescription is the description of the Plugin Framework's unconditional RequiresReplace plan modifiers.
+const frameworkRequiresReplaceDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+
+func main() {
+	output := flag.String("o", "", "output file")
+	flag.Parse()
+
+	if *output == "" {
+		fmt.Fprintln(os.Stderr, "-o is required")
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	schemas, err := dump(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	b, err := json.Marshal(schemas)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*output, b, 0644); err != nil { //nolint:mnd // good enough
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func dump(ctx context.Context) (*ProviderSchemas, error) {
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, diag := range response.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("getting provider schema: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	schemas := &ProviderSchemas{
+		Resources:          make(map[string]*Block),
+		DataSources:        make(map[string]*Block),
+		EphemeralResources: make(map[string]*Block),
+		Functions:          make(map[string]*Function),
+	}
+
+	for name, v := range response.ResourceSchemas {
+		schemas.Resources[name] = expandBlock(v.Block)
+	}
+	for name, v := range response.DataSourceSchemas {
+		schemas.DataSources[name] = expandBlock(v.Block)
+	}
+	for name, v := range response.EphemeralResourceSchemas {
+		schemas.EphemeralResources[name] = expandBlock(v.Block)
+	}
+	for name, v := range response.Functions {
+		schemas.Functions[name] = expandFunction(v)
+	}
+
+	// The protocol doesn't describe whether an attribute forces replacement or what its default value is.
+	for name, r := range primary.ResourcesMap {
+		if block, ok := schemas.Resources[name]; ok {
+			augmentFromSDKSchema(block, r.SchemaMap())
+		}
+	}
+
+	fwProvider, err := framework.NewProvider(ctx, primary)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, factory := range fwProvider.Resources(ctx) {
+		r := factory()
+
+		metadataResponse := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+
+		block, ok := schemas.Resources[metadataResponse.TypeName]
+		if !ok {
+			continue
+		}
+
+		schemaResponse := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+		if schemaResponse.Diagnostics.HasError() {
+			return nil, fmt.Errorf("getting %s schema: %v", metadataResponse.TypeName, schemaResponse.Diagnostics)
+		}
+
+		augmentFromFrameworkSchema(ctx, block, reflect.ValueOf(schemaResponse.Schema))
+	}
+
+	return schemas, nil
+}
+
+func expandBlock(apiObject *tfprotov5.SchemaBlock) *Block {
+	block := &Block{
+		Attributes: make(map[string]*Attribute),
+		Blocks:     make(map[string]*NestedBlock),
+	}
+
+	if apiObject == nil {
+		return block
+	}
+
+	for _, v := range apiObject.Attributes {
+		block.Attributes[v.Name] = &Attribute{
+			Type:       typeConstraint(v.Type),
+			Required:   v.Required,
+			Optional:   v.Optional,
+			Computed:   v.Computed,
+			Sensitive:  v.Sensitive,
+			Deprecated: v.Deprecated,
+		}
+	}
+
+	for _, v := range apiObject.BlockTypes {
+		block.Blocks[v.TypeName] = &NestedBlock{
+			Block:      *expandBlock(v.Block),
+			Nesting:    strings.ToLower(v.Nesting.String()),
+			MinItems:   v.MinItems,
+			MaxItems:   v.MaxItems,
+			Deprecated: v.Block != nil && v.Block.Deprecated,
+		}
+	}
+
+	return block
+}
+
+func expandFunction(apiObject *tfprotov5.Function) *Function {
+	function := &Function{
+		Parameters: make([]*FunctionParameter, 0, len(apiObject.Parameters)),
+	}
+
+	for _, v := range apiObject.Parameters {
+		function.Parameters = append(function.Parameters, &FunctionParameter{
+			Name: v.Name,
+			Type: typeConstraint(v.Type),
+		})
+	}
+
+	if v := apiObject.VariadicParameter; v != nil {
+		function.VariadicParameter = &FunctionParameter{
+			Name: v.Name,
+			Type: typeConstraint(v.Type),
+		}
+	}
+
+	if v := apiObject.Return; v != nil {
+		function.Return = typeConstraint(v.Type)
+	}
+
+	return function
+}
+
+// typeConstraint returns the Terraform type constraint syntax for the specified type.
+func typeConstraint(t tftypes.Type) string {
+	switch t := t.(type) {
+	case nil:
+		return ""
+	case tftypes.List:
+		return "list(" + typeConstraint(t.ElementType) + ")"
+	case tftypes.Set:
+		return "set(" + typeConstraint(t.ElementType) + ")"
+	case tftypes.Map:
+		return "map(" + typeConstraint(t.ElementType) + ")"
+	case tftypes.Object:
+		names := make([]string, 0, len(t.AttributeTypes))
+		for name := range t.AttributeTypes {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		attributes := make([]string, 0, len(names))
+		for _, name := range names {
+			v := typeConstraint(t.AttributeTypes[name])
+			if _, ok := t.OptionalAttributes[name]; ok {
+				v = "optional(" + v + ")"
+			}
+			attributes = append(attributes, name+"="+v)
+		}
+
+		return "object({" + strings.Join(attributes, ",") + "})"
+	case tftypes.Tuple:
+		elements := make([]string, 0, len(t.ElementTypes))
+		for _, v := range t.ElementTypes {
+			elements = append(elements, typeConstraint(v))
+		}
+
+		return "tuple([" + strings.Join(elements, ",") + "])"
+	}
+
+	switch {
+	case t.Is(tftypes.String):
+		return "string"
+	case t.Is(tftypes.Number):
+		return "number"
+	case t.Is(tftypes.Bool):
+		return "bool"
+	case t.Is(tftypes.DynamicPseudoType):
+		return "any"
+	}
+
+	return t.String()
+}
+
+func augmentFromSDKSchema(block *Block, schemaMap map[string]*schema.Schema) {
+	for name, v := range schemaMap {
+		if attribute, ok := block.Attributes[name]; ok {
+			attribute.ForceNew = v.ForceNew
+			if v.Default != nil {
+				if b, err := json.Marshal(v.Default); err == nil {
+					attribute.Default = string(b)
+				}
+			}
+			continue
+		}
+
+		if nestedBlock, ok := block.Blocks[name]; ok {
+			nestedBlock.ForceNew = v.ForceNew
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				augmentFromSDKSchema(&nestedBlock.Block, elem.SchemaMap())
+			}
+		}
+	}
+}
+
+// augmentFromFrameworkSchema walks a Plugin Framework schema (or nested block object) using reflection.
+// Each attribute and block type is handled identically via its PlanModifiers and Default fields.
+func augmentFromFrameworkSchema(ctx context.Context, block *Block, v reflect.Value) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	if f := v.FieldByName("Attributes"); f.IsValid() && f.Kind() == reflect.Map {
+		for iter := f.MapRange(); iter.Next(); {
+			attribute, ok := block.Attributes[iter.Key().String()]
+			if !ok {
+				continue
+			}
+
+			elem := reflect.Indirect(iter.Value().Elem())
+			attribute.ForceNew = frameworkForceNew(ctx, elem)
+			attribute.Default = frameworkDefault(ctx, elem)
+		}
+	}
+
+	if f := v.FieldByName("Blocks"); f.IsValid() && f.Kind() == reflect.Map {
+		for iter := f.MapRange(); iter.Next(); {
+			nestedBlock, ok := block.Blocks[iter.Key().String()]
+			if !ok {
+				continue
+			}
+
+			elem := reflect.Indirect(iter.Value().Elem())
+			nestedBlock.ForceNew = frameworkForceNew(ctx, elem)
+
+			if o := elem.FieldByName("NestedObject"); o.IsValid() {
+				augmentFromFrameworkSchema(ctx, &nestedBlock.Block, o)
+			} else {
+				// Single nested blocks have their attributes and blocks directly.
+				augmentFromFrameworkSchema(ctx, &nestedBlock.Block, elem)
+			}
+		}
+	}
+}
+
+func frameworkForceNew(ctx context.Context, v reflect.Value) bool {
+	f := v.FieldByName("PlanModifiers")
+	if !f.IsValid() || f.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := range f.Len() {
+		if d, ok := f.Index(i).Interface().(interface {
+			Description(context.Context) string
+		}); ok && d.Description(ctx) == frameworkRequiresReplaceDescription {
+			return true
+		}
+	}
+
+	return false
+}
+
+// frameworkDefault returns the default value of an attribute as a string.
+// The default is obtained by calling the attribute's defaults.<Type> implementation,
+// e.g. DefaultString(context.Context, defaults.StringRequest, *defaults.StringResponse).
+func frameworkDefault(ctx context.Context, v reflect.Value) string {
+	f := v.FieldByName("Default")
+	if !f.IsValid() || f.Kind() != reflect.Interface || f.IsNil() {
+		return ""
+	}
+
+	d := f.Elem()
+	for i := range d.NumMethod() {
+		name := d.Type().Method(i).Name
+		if !strings.HasPrefix(name, "Default") {
+			continue
+		}
+
+		m := d.Method(i)
+		if t := m.Type(); t.NumIn() != 3 || t.In(2).Kind() != reflect.Pointer { //nolint:mnd // context, request, response
+			continue
+		}
+
+		request := reflect.New(m.Type().In(1)).Elem()
+		response := reflect.New(m.Type().In(2).Elem())
+		m.Call([]reflect.Value{reflect.ValueOf(ctx), request, response})
+
+		if value, ok := response.Elem().FieldByName("PlanValue").Interface().(fmt.Stringer); ok {
+			return value.String()
+		}
+	}
+
+	return ""
+}
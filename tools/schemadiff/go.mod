@@ -0,0 +1,3 @@
+module github.com/hashicorp/terraform-provider-aws/tools/schemadiff
+
+go 1.24.4
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	//go:embed dump.go
+	dumpSource []byte
+	//go:embed schema.go
+	schemaSource []byte
+)
+
+var (
+	changelogFile  = flag.String("changelog", "", "file to write the CHANGELOG-style summary to (default is stdout)")
+	failOnBreaking = flag.Bool("fail-on-breaking", false, "exit with status 3 if any change is breaking")
+	repository     = flag.String("repository", ".", "path to the provider's git repository")
+	reportFile     = flag.String("report", "", "file to write the machine-readable JSON report to")
+	schemasDir     = flag.String("schemas-dir", "", "directory to save each revision's dumped schemas to, as <commit>.json")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tschemadiff [-repository <path>] [-report <file>] [-changelog <file>] [-schemas-dir <dir>] <old> <new>\n\n")
+	fmt.Fprintf(os.Stderr, "<old> and <new> are git revisions or the paths of JSON files previously saved with -schemas-dir.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+
+	if len(args) != 2 { //nolint:mnd // old and new
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	old, err := loadSchemas(args[0])
+	if err != nil {
+		fatalf("loading %s: %s", args[0], err)
+	}
+
+	new, err := loadSchemas(args[1])
+	if err != nil {
+		fatalf("loading %s: %s", args[1], err)
+	}
+
+	report := diff(old, new)
+
+	if v := *reportFile; v != "" {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fatalf("encoding report: %s", err)
+		}
+
+		if err := os.WriteFile(v, b, 0644); err != nil { //nolint:mnd // good enough
+			fatalf("writing %s: %s", v, err)
+		}
+	}
+
+	changelog := report.Changelog()
+
+	if v := *changelogFile; v != "" {
+		if err := os.WriteFile(v, []byte(changelog), 0644); err != nil { //nolint:mnd // good enough
+			fatalf("writing %s: %s", v, err)
+		}
+	} else {
+		fmt.Print(changelog)
+	}
+
+	if *failOnBreaking && report.HasBreakingChanges() {
+		os.Exit(3) //nolint:mnd // distinct from usage and runtime errors
+	}
+}
+
+// loadSchemas returns the provider schemas for the specified git revision.
+// If arg names an existing JSON file its contents are used instead, allowing a dumped schema to be reused.
+func loadSchemas(arg string) (*ProviderSchemas, error) {
+	if strings.HasSuffix(arg, ".json") {
+		if _, err := os.Stat(arg); err == nil {
+			return readSchemas(arg)
+		}
+	}
+
+	return dumpRevision(arg)
+}
+
+func readSchemas(filename string) (*ProviderSchemas, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas ProviderSchemas
+	if err := json.Unmarshal(b, &schemas); err != nil {
+		return nil, err
+	}
+
+	return &schemas, nil
+}
+
+// dumpRevision checks out the specified revision into a temporary git worktree and runs the schema dumper there.
+func dumpRevision(revision string) (*ProviderSchemas, error) {
+	commit, err := git("rev-parse", "--verify", revision+"^{commit}")
+	if err != nil {
+		return nil, err
+	}
+
+	tempDir, err := os.MkdirTemp("", "schemadiff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	worktree := filepath.Join(tempDir, "worktree")
+	infof("checking out %s (%s)", revision, commit)
+	if _, err := git("worktree", "add", "--detach", worktree, commit); err != nil {
+		return nil, err
+	}
+	defer git("worktree", "remove", "--force", worktree) //nolint:errcheck // best effort
+
+	dumperDir := filepath.Join(worktree, "tools", "schemadiffdump")
+	if err := os.MkdirAll(dumperDir, 0755); err != nil { //nolint:mnd // good enough
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dumperDir, "dump.go"), dumpSource, 0644); err != nil { //nolint:mnd // good enough
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dumperDir, "schema.go"), schemaSource, 0644); err != nil { //nolint:mnd // good enough
+		return nil, err
+	}
+
+	output := filepath.Join(tempDir, "schemas.json")
+	infof("dumping provider schemas at %s", revision)
+	cmd := exec.Command("go", "run", "-tags", "schemadiffdump", "./tools/schemadiffdump", "-o", output)
+	cmd.Dir = worktree
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running schema dumper: %w", err)
+	}
+
+	schemas, err := readSchemas(output)
+	if err != nil {
+		return nil, err
+	}
+	schemas.Revision = commit
+
+	if v := *schemasDir; v != "" {
+		if err := os.MkdirAll(v, 0755); err != nil { //nolint:mnd // good enough
+			return nil, err
+		}
+
+		b, err := json.Marshal(schemas)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(filepath.Join(v, commit+".json"), b, 0644); err != nil { //nolint:mnd // good enough
+			return nil, err
+		}
+	}
+
+	return schemas, nil
+}
+
+func git(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", *repository}, args...)...)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func infof(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", a...)
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", a...)
+	os.Exit(1)
+}
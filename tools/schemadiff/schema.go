@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+// The types in this file describe a provider's schemas independently of the Terraform Plugin SDK
+// and Terraform Plugin Framework.
+// They are shared by the schema dumper, which runs inside a checkout of each provider revision,
+// and the differ so must only depend on the standard library.
+
+// ProviderSchemas is the schema of every resource type, data source, ephemeral resource and function.
+type ProviderSchemas struct {
+	Revision           string               `json:"revision,omitempty"`
+	Resources          map[string]*Block    `json:"resources"`
+	DataSources        map[string]*Block    `json:"data_sources"`
+	EphemeralResources map[string]*Block    `json:"ephemeral_resources"`
+	Functions          map[string]*Function `json:"functions"`
+}
+
+// Block is a schema block.
+// A resource type's top-level schema is a Block.
+type Block struct {
+	Attributes map[string]*Attribute   `json:"attributes,omitempty"`
+	Blocks     map[string]*NestedBlock `json:"blocks,omitempty"`
+}
+
+// Attribute is a schema attribute.
+type Attribute struct {
+	// Type is the attribute's type in Terraform type constraint syntax, e.g. list(string).
+	Type string `json:"type"`
+
+	Required   bool `json:"required,omitempty"`
+	Optional   bool `json:"optional,omitempty"`
+	Computed   bool `json:"computed,omitempty"`
+	Sensitive  bool `json:"sensitive,omitempty"`
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// ForceNew is set if a change to the attribute's value always requires the resource to be replaced.
+	ForceNew bool `json:"force_new,omitempty"`
+	// Default describes the attribute's default value, if any.
+	Default string `json:"default,omitempty"`
+}
+
+// NestedBlock is a nested schema block.
+type NestedBlock struct {
+	Block
+
+	Nesting    string `json:"nesting"`
+	MinItems   int64  `json:"min_items,omitempty"`
+	MaxItems   int64  `json:"max_items,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+	ForceNew   bool   `json:"force_new,omitempty"`
+}
+
+// Function is a provider-defined function signature.
+type Function struct {
+	Parameters        []*FunctionParameter `json:"parameters"`
+	VariadicParameter *FunctionParameter   `json:"variadic_parameter,omitempty"`
+	Return            string               `json:"return"`
+}
+
+// FunctionParameter is a provider-defined function parameter.
+type FunctionParameter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
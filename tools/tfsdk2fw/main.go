@@ -11,11 +11,17 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	provider "github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -57,10 +63,10 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
+	p, err := provider.NewProvider(context.Background())
 
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err)
 	}
 
 	if v := *dataSourceType; v != "" {
@@ -83,6 +89,7 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = migrateTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -98,10 +105,12 @@ type migrator struct {
 	PackageName  string
 	Resource     *schema.Resource
 	Template     string
+	TestTemplate string
 	TFTypeName   string
 }
 
-// migrate generates an identical schema into the specified output file.
+// migrate generates an identical schema, the resource model and CRUD skeletons into the specified output file.
+// For resources a state compatibility acceptance test is generated alongside the output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -125,6 +134,23 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_migrate_test.go"
+	m.infof("generating migration test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.BufferTemplate("migratetest", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
@@ -145,23 +171,52 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+		DefaultCreateTimeout:       emitter.DefaultCreateTimeout,
+		DefaultReadTimeout:         emitter.DefaultReadTimeout,
+		DefaultUpdateTimeout:       emitter.DefaultUpdateTimeout,
+		DefaultDeleteTimeout:       emitter.DefaultDeleteTimeout,
+		EmitResourceImportState:    m.Resource.Importer != nil,
+		EmitResourceTags:           !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		EmitResourceUpdateSkeleton: m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTimeouts:                emitter.HasTimeouts,
+		Models:                     emitter.Models,
+		Name:                       m.Name,
+		PackageName:                m.PackageName,
+		Schema:                     sbSchema.String(),
+		SchemaVersion:              m.Resource.SchemaVersion,
+		Struct:                     sbStruct.String(),
+		TFTypeName:                 m.TFTypeName,
+	}
+
+	if !m.IsDataSource {
+		if m.Resource.MigrateState != nil {
+			m.Generator.Warnf("Legacy MigrateState function is not migrated")
+		}
+
+		for _, v := range m.Resource.StateUpgraders {
+			stateUpgrader, err := emitter.emitStateUpgrader(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("emitting state upgrader (version %d) code: %w", v.Version, err)
+			}
+
+			templateData.StateUpgraders = append(templateData.StateUpgraders, stateUpgrader)
+		}
+
+		service, err := data.LookupService(m.PackageName)
+
+		if err != nil {
+			return nil, fmt.Errorf("looking up service: %w", err)
+		}
+
+		templateData.ProviderNameUpper = service.ProviderNameUpper()
+		templateData.TopLevelProperties = emitter.TopLevelProperties
 	}
 
+	// Set after any state upgraders have been emitted.
+	templateData.ImportFrameworkAttr = emitter.ImportFrameworkAttr
+	templateData.ImportProviderFrameworkTypes = emitter.ImportProviderFrameworkTypes
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -200,8 +255,11 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	Models                        []string // Model structs for nested blocks, outermost first.
+	ModelNames                    []string
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
+	TopLevelProperties            []topLevelProperty
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -294,24 +352,26 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+		fprintf(e.SchemaWriter, ",\n")
+
 		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+			e.TopLevelProperties = append(e.TopLevelProperties, topLevelProperty{
+				FieldName: naming.ToCamelCase(name),
+				Name:      name,
+			})
 		}
-
-		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
 		fprintf(e.SchemaWriter, "},\n")
@@ -331,6 +391,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -338,7 +399,15 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
+
+		if isTopLevelAttribute {
+			e.TopLevelProperties = append(e.TopLevelProperties, topLevelProperty{
+				FieldName: naming.ToCamelCase(name),
+				Name:      name,
+			})
+		}
 	}
 	if emittedFieldName {
 		fprintf(e.SchemaWriter, "},\n")
@@ -364,51 +433,40 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
 
 	case schema.TypeString:
-		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		if v, ok := e.diffSuppressCustomType(property); ok {
+			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
+			fprintf(e.SchemaWriter, "CustomType:%s,\n", v.Type)
+			fprintf(e.StructWriter, "%s", v.ValueType)
+		} else if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+			// Computed-only ARN attributes are easiest handled as strings.
 			e.ImportProviderFrameworkTypes = true
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,9 +483,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -438,9 +494,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -451,9 +505,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -609,6 +661,12 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "// TODO Validate,\n")
 	}
 
+	if f := property.DiffSuppressFunc; f != nil {
+		if _, ok := diffSuppressCustomTypes[funcName(f)]; !ok || property.Type != schema.TypeString {
+			fprintf(e.SchemaWriter, "// TODO DiffSuppressFunc: %s,\n", funcName(f))
+		}
+	}
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
@@ -633,10 +691,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitBlockModel(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -656,10 +719,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitBlockModel(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -731,6 +799,45 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitBlockModel generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the generated code to the emitter's Writer.
+// The nested object's model struct is added to the emitter's Models.
+func (e *emitter) emitBlockModel(path []string, modelName string, schema map[string]*schema.Schema) error {
+	// Reserve the model's position so that outer models precede inner models.
+	i := len(e.Models)
+	e.Models = append(e.Models, "")
+
+	structWriter := e.StructWriter
+	sbStruct := strings.Builder{}
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	e.Models[i] = fmt.Sprintf("type %s struct {\n%s}", modelName, sbStruct.String())
+
+	return nil
+}
+
+// modelName returns the name of the model struct for the Plugin SDK Block at the specified path.
+// The Block's name is used unless another Block's model already has that name.
+func (e *emitter) modelName(path []string) string {
+	name := lowerFirst(naming.ToCamelCase(path[len(path)-1])) + "Model"
+
+	if slices.Contains(e.ModelNames, name) {
+		name = lowerFirst(naming.ToCamelCase(strings.Join(path, "_"))) + "Model"
+	}
+
+	e.ModelNames = append(e.ModelNames, name)
+
+	return name
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -854,6 +961,214 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// diffSuppressCustomType returns the Plugin Framework custom type equivalent to a Plugin SDK Attribute's DiffSuppressFunc.
+func (e *emitter) diffSuppressCustomType(property *schema.Schema) (customType, bool) {
+	if property.DiffSuppressFunc == nil {
+		return customType{}, false
+	}
+
+	v, ok := diffSuppressCustomTypes[funcName(property.DiffSuppressFunc)]
+
+	if !ok {
+		return customType{}, false
+	}
+
+	if v.Import.Path == "" {
+		e.ImportProviderFrameworkTypes = true
+	} else {
+		e.GoImports = append(e.GoImports, v.Import)
+	}
+
+	return v, true
+}
+
+// emitStateUpgrader generates the Plugin Framework code for a Plugin SDK StateUpgrader's prior schema and state.
+func (e *emitter) emitStateUpgrader(upgrader schema.StateUpgrader) (stateUpgrader, error) {
+	sbSchema := strings.Builder{}
+	sbStruct := strings.Builder{}
+	schemaWriter, structWriter := e.SchemaWriter, e.StructWriter
+	e.SchemaWriter, e.StructWriter = &sbSchema, &sbStruct
+	defer func() {
+		e.SchemaWriter, e.StructWriter = schemaWriter, structWriter
+	}()
+
+	if !upgrader.Type.IsObjectType() {
+		return stateUpgrader{}, fmt.Errorf("prior state is of unsupported type: %s", upgrader.Type.FriendlyName())
+	}
+
+	fprintf(e.SchemaWriter, "schema.Schema{\n")
+	fprintf(e.SchemaWriter, "Version:%d,\n", upgrader.Version)
+
+	attributeTypes := upgrader.Type.AttributeTypes()
+	names := make([]string, 0)
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	if len(names) > 0 {
+		fprintf(e.SchemaWriter, "Attributes: map[string]schema.Attribute{\n")
+	}
+	for _, name := range names {
+		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
+
+		if err := e.emitPriorStateAttribute([]string{name}, attributeTypes[name]); err != nil {
+			return stateUpgrader{}, err
+		}
+
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+		fprintf(e.SchemaWriter, ",\n")
+	}
+	if len(names) > 0 {
+		fprintf(e.SchemaWriter, "},\n")
+	}
+
+	fprintf(e.SchemaWriter, "}")
+
+	return stateUpgrader{
+		Schema:  sbSchema.String(),
+		Struct:  sbStruct.String(),
+		Version: upgrader.Version,
+	}, nil
+}
+
+// emitPriorStateAttribute generates the Plugin Framework code for an attribute of a Plugin SDK StateUpgrader's prior state
+// and emits the generated code to the emitter's Writer.
+// Only the attribute's type is significant when reading prior state so all attributes are Optional.
+func (e *emitter) emitPriorStateAttribute(path []string, ty cty.Type) error {
+	switch {
+	case ty == cty.Bool:
+		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
+		fprintf(e.StructWriter, "types.Bool")
+
+	case ty == cty.Number:
+		fprintf(e.SchemaWriter, "schema.NumberAttribute{\n")
+		fprintf(e.StructWriter, "types.Number")
+
+	case ty == cty.String:
+		fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
+		fprintf(e.StructWriter, "types.String")
+
+	case ty.IsListType(), ty.IsMapType(), ty.IsSetType():
+		var aggregateSchemaFactory string
+
+		switch {
+		case ty.IsListType():
+			aggregateSchemaFactory = "schema.ListAttribute{"
+			fprintf(e.StructWriter, "types.List")
+		case ty.IsMapType():
+			aggregateSchemaFactory = "schema.MapAttribute{"
+			fprintf(e.StructWriter, "types.Map")
+		case ty.IsSetType():
+			aggregateSchemaFactory = "schema.SetAttribute{"
+			fprintf(e.StructWriter, "types.Set")
+		}
+
+		elementType, err := e.priorStateType(path, ty.ElementType())
+
+		if err != nil {
+			return err
+		}
+
+		fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+		fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
+
+	case ty.IsObjectType():
+		attributeTypes, err := e.priorStateAttributeTypes(path, ty)
+
+		if err != nil {
+			return err
+		}
+
+		fprintf(e.SchemaWriter, "schema.ObjectAttribute{\n")
+		fprintf(e.SchemaWriter, "AttributeTypes:%s,\n", attributeTypes)
+		fprintf(e.StructWriter, "types.Object")
+
+	default:
+		return unsupportedTypeError(path, ty.FriendlyName())
+	}
+
+	fprintf(e.SchemaWriter, "Optional:true,\n")
+	fprintf(e.SchemaWriter, "}")
+
+	return nil
+}
+
+// priorStateType returns the Plugin Framework type expression for a value in a Plugin SDK StateUpgrader's prior state.
+func (e *emitter) priorStateType(path []string, ty cty.Type) (string, error) {
+	switch {
+	case ty == cty.Bool:
+		return "types.BoolType", nil
+
+	case ty == cty.Number:
+		return "types.NumberType", nil
+
+	case ty == cty.String:
+		return "types.StringType", nil
+
+	case ty.IsListType(), ty.IsMapType(), ty.IsSetType():
+		var aggregateType string
+
+		switch {
+		case ty.IsListType():
+			aggregateType = "types.ListType"
+		case ty.IsMapType():
+			aggregateType = "types.MapType"
+		case ty.IsSetType():
+			aggregateType = "types.SetType"
+		}
+
+		elementType, err := e.priorStateType(path, ty.ElementType())
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s{ElemType:%s}", aggregateType, elementType), nil
+
+	case ty.IsObjectType():
+		attributeTypes, err := e.priorStateAttributeTypes(path, ty)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ObjectType{AttrTypes:%s}", attributeTypes), nil
+
+	default:
+		return "", unsupportedTypeError(path, ty.FriendlyName())
+	}
+}
+
+// priorStateAttributeTypes returns the Plugin Framework attribute types expression for an object in a Plugin SDK StateUpgrader's prior state.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) priorStateAttributeTypes(path []string, ty cty.Type) (string, error) {
+	attributeTypes := ty.AttributeTypes()
+	names := make([]string, 0)
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	e.ImportFrameworkAttr = true
+
+	sb := strings.Builder{}
+	fprintf(&sb, "map[string]attr.Type{\n")
+	for _, name := range names {
+		v, err := e.priorStateType(append(path, name), attributeTypes[name])
+
+		if err != nil {
+			return "", err
+		}
+
+		fprintf(&sb, "%q:%s,\n", name, v)
+	}
+	fprintf(&sb, "}")
+
+	return sb.String(), nil
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...any) {
 	e.Generator.Warnf(format, a...)
@@ -897,6 +1212,20 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// funcName returns the fully qualified name of the specified function.
+func funcName(f any) string {
+	return runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+}
+
+// lowerFirst returns the specified string with its first letter lowercased.
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
@@ -907,7 +1236,7 @@ type templateData struct {
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
 	EmitResourceImportState       bool
-	EmitResourceModifyPlan        bool
+	EmitResourceTags              bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
@@ -915,11 +1244,59 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        []string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	ProviderNameUpper             string // e.g. EC2
 	Schema                        string
+	SchemaVersion                 int
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	TopLevelProperties            []topLevelProperty
+}
+
+type stateUpgrader struct {
+	Schema  string // Prior schema.
+	Struct  string // Prior state model struct fields.
+	Version int    // Prior schema version.
+}
+
+type topLevelProperty struct {
+	FieldName string // e.g. ARN
+	Name      string // e.g. arn
+}
+
+// customType is a Plugin Framework custom type.
+type customType struct {
+	Import    goImport // Package containing the custom type, if not the provider's framework types package.
+	Type      string   // Schema CustomType expression.
+	ValueType string   // Model struct field type.
+}
+
+var (
+	caseInsensitiveStringType = customType{
+		Type:      "fwtypes.CaseInsensitiveStringType",
+		ValueType: "fwtypes.CaseInsensitiveString",
+	}
+	iamPolicyType = customType{
+		Type:      "fwtypes.IAMPolicyType",
+		ValueType: "fwtypes.IAMPolicy",
+	}
+	jsonNormalizedType = customType{
+		Import:    goImport{Path: "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"},
+		Type:      "jsontypes.NormalizedType{}",
+		ValueType: "jsontypes.Normalized",
+	}
+)
+
+// diffSuppressCustomTypes maps the names of Plugin SDK DiffSuppressFuncs to equivalent Plugin Framework custom types.
+var diffSuppressCustomTypes = map[string]customType{
+	funcName(sdkv2.SuppressEquivalentIAMPolicyDocuments):    iamPolicyType,
+	funcName(sdkv2.SuppressEquivalentJSONDocuments):         jsonNormalizedType,
+	funcName(sdkv2.SuppressEquivalentStringCaseInsensitive): caseInsensitiveStringType,
+	funcName(verify.SuppressEquivalentJSONDiffs):            jsonNormalizedType,
+	funcName(verify.SuppressEquivalentPolicyDiffs):          iamPolicyType,
 }
 
 //go:embed datasource.gtpl
@@ -928,6 +1305,9 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed migrate_test.gtpl
+var migrateTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string